@@ -3,7 +3,6 @@ module github.com/exgamer/gosdk-core
 go 1.23.0
 
 require (
-	github.com/davecgh/go-spew v1.1.1
 	github.com/getsentry/sentry-go v0.24.1
 	github.com/gin-gonic/gin v1.9.1
 	github.com/go-playground/validator/v10 v10.15.1
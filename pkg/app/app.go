@@ -2,12 +2,18 @@ package app
 
 import (
 	"context"
-	"github.com/davecgh/go-spew/spew"
+	"errors"
+	"fmt"
 	"github.com/exgamer/gosdk-core/pkg/config"
 	"github.com/exgamer/gosdk-core/pkg/di"
+	"github.com/exgamer/gosdk-core/pkg/exception"
+	"github.com/exgamer/gosdk-core/pkg/logger"
+	"github.com/exgamer/gosdk-core/pkg/reporter"
 	"log"
+	"log/slog"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -18,19 +24,29 @@ func NewApp() *App {
 }
 
 type App struct {
-	BaseConfig *config.BaseConfig
-	Location   *time.Location
-	Container  *di.Container
+	BaseConfig     *config.BaseConfig
+	ConfigLoader   *config.Loader
+	Logger         *slog.Logger
+	LoggerRegistry *logger.Registry
+	// Reporter отправка ошибок (Sentry). Если не задан, создаётся по SENTRY_* настройкам.
+	Reporter reporter.Reporter
+	// PanicPolicy реакция на панику или ошибку в горутинах SafeGo (по умолчанию PanicFail)
+	PanicPolicy PanicPolicy
+	Location    *time.Location
+	Container   *di.Container
 
 	KernelManager *KernelManager
 	ModuleManager *ModuleManager
 
 	stopHooks       []func(ctx context.Context) error
 	shutdownTimeout time.Duration
+	configSections  []config.Section
 	once            sync.Once
 	shutdownOnce    sync.Once
+	reportOnce      sync.Once
 	mu              sync.Mutex
 	initErr         error
+	logOutput       *logger.Output
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -58,7 +74,11 @@ func (app *App) RegisterModules(m ...ModuleInterface) error {
 		return err
 	}
 
-	return app.ModuleManager.RegisterAll(m...)
+	if err := app.ModuleManager.RegisterAll(m...); err != nil {
+		return err
+	}
+
+	return app.loadModuleConfigs(m...)
 }
 
 // RegisterModule регистрирует модуль
@@ -67,7 +87,11 @@ func (app *App) RegisterModule(m ModuleInterface) error {
 		return err
 	}
 
-	return app.ModuleManager.Register(m)
+	if err := app.ModuleManager.Register(m); err != nil {
+		return err
+	}
+
+	return app.loadModuleConfigs(m)
 }
 
 func (app *App) InitModules() error {
@@ -75,9 +99,109 @@ func (app *App) InitModules() error {
 		return err
 	}
 
+	app.reportOnce.Do(func() {
+		if app.BaseConfig.AppEnv.Profile().DumpConfig {
+			app.logConfigReport()
+		}
+	})
+
 	return app.ModuleManager.InitAll(app)
 }
 
+// loadModuleConfigs загружает, валидирует и регистрирует в DI секции конфигурации модулей.
+// Ошибки всех модулей возвращаются одной ошибкой.
+func (app *App) loadModuleConfigs(modules ...ModuleInterface) error {
+	errs := make([]error, 0)
+
+	for _, m := range modules {
+		cm, ok := m.(ConfigurableModuleInterface)
+		if !ok {
+			continue
+		}
+
+		// секция перезагружается вместе с конфигом: актуальное значение - через binding
+		prefix, target := cm.ConfigSection()
+		binding, err := app.ConfigLoader.BindSection(prefix, target)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("config %s: %w", m.Name(), err))
+
+			continue
+		}
+
+		// неизвестные переменные с префиксом модуля - скорее всего опечатка
+		if unknown := config.UnknownKeys(app.ConfigLoader.Values(), prefix, target); len(unknown) > 0 {
+			if app.BaseConfig.AppEnv.Profile().StrictValidation {
+				errs = append(errs, fmt.Errorf("config %s: unknown variables: %s", m.Name(), strings.Join(unknown, ", ")))
+
+				continue
+			}
+
+			log.Printf("Config %s: unknown variables: %s", m.Name(), strings.Join(unknown, ", "))
+		}
+
+		di.Register(app.Container, target)
+		di.RegisterNamed(app.Container, m.Name(), binding)
+
+		app.mu.Lock()
+		app.configSections = append(app.configSections, config.Section{Name: m.Name(), Prefix: prefix, Target: target})
+		app.mu.Unlock()
+	}
+
+	return errors.Join(errs...)
+}
+
+// NamedLogger логгер модуля или ядра (по Name()), уровень которого можно менять отдельно (LOG_LEVELS)
+func (app *App) NamedLogger(name string) *slog.Logger {
+	if app.LoggerRegistry == nil {
+		return logger.Named(name)
+	}
+
+	return app.LoggerRegistry.Named(name)
+}
+
+// PrintConfigRequested запрошен вывод действующего конфига (--print-config или PRINT_CONFIG=true).
+// Проверяется в main после регистрации модулей: вывести ConfigReport и завершиться.
+func (app *App) PrintConfigRequested() bool {
+	if err := app.ensureInit(); err != nil {
+		return false
+	}
+
+	v, _ := app.ConfigLoader.Lookup(config.PrintConfigKey)
+
+	return v == "true"
+}
+
+// ConfigReport отчёт с действующими настройками приложения и модулей
+func (app *App) ConfigReport() string {
+	return config.Report(app.ConfigSections()...)
+}
+
+// logConfigReport пишет действующие настройки в лог: одна запись, секции - группы полей
+func (app *App) logConfigReport() {
+	args := make([]any, 0)
+
+	for _, section := range app.ConfigSections() {
+		values := config.Redacted(section.Target, section.Prefix)
+		fields := make([]any, 0, len(values))
+
+		for _, info := range config.Describe(section.Target, section.Prefix) {
+			fields = append(fields, slog.String(info.Env, values[info.Env]))
+		}
+
+		args = append(args, slog.Group(section.Name, fields...))
+	}
+
+	app.Logger.Info("config", args...)
+}
+
+// ConfigSections секции конфигурации приложения и модулей (для документации: config.DescribeSections)
+func (app *App) ConfigSections() []config.Section {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	return append([]config.Section(nil), app.configSections...)
+}
+
 // InitModule выполняет init модуля (один раз)
 func (app *App) InitModule(name string) error {
 	if err := app.ensureInit(); err != nil {
@@ -181,20 +305,99 @@ func (app *App) initApp() error {
 
 	// Config
 	{
-		if err := config.ReadEnv(); err != nil {
+		if app.ConfigLoader == nil {
+			app.ConfigLoader = config.NewDefaultLoader()
+		}
+
+		if err := app.ConfigLoader.Load(); err != nil {
 			return err
 		}
 
+		di.Register(app.Container, app.ConfigLoader)
+
 		baseConfig := &config.BaseConfig{}
-		if err := config.InitConfig(baseConfig); err != nil {
+		if err := app.ConfigLoader.Decode(baseConfig); err != nil {
 			return err
 		}
 
-		spew.Dump(baseConfig) // TODO убрать
+		// в окружениях, где debug запрещён (production), DEBUG принудительно выключается
+		if baseConfig.Debug && !baseConfig.AppEnv.Profile().DebugAllowed {
+			log.Printf("DEBUG is not allowed in %s environment, disabled", baseConfig.AppEnv)
+			baseConfig.Debug = false
+		}
+
 		app.BaseConfig = baseConfig
+		app.configSections = append(app.configSections, config.Section{Name: "app", Target: baseConfig})
+
+		// перезагрузка конфига по SIGHUP, а при CONFIG_WATCH_INTERVAL > 0 - и при изменении файлов
+		app.ConfigLoader.Watch(app.ctx, baseConfig.ConfigWatchInterval)
 		di.Register(app.Container, app.BaseConfig)
 	}
 
+	// Logger
+	{
+		// конфиг логгера перезагружается на лету: LOG_LEVEL и LOG_LEVELS применяются без рестарта
+		loggerConfig, err := config.Bind[logger.Config](app.ConfigLoader, "")
+		if err != nil {
+			return err
+		}
+
+		output, err := logger.NewOutput(*loggerConfig.Get())
+		if err != nil {
+			return err
+		}
+
+		registry, err := logger.NewRegistryFromConfig(*loggerConfig.Get(), output.Sink)
+		if err != nil {
+			return err
+		}
+
+		// дописать буферизированные логи при остановке (хук добавлен первым, поэтому выполнится последним).
+		// Закрывается вывод в WaitForShutdown после последней записи лога.
+		app.logOutput = output
+		app.AddStopHook(output.Flush)
+
+		if output.Memory != nil {
+			di.Register(app.Container, output.Memory)
+		}
+
+		loggerConfig.Subscribe(func(e config.ChangeEvent[logger.Config]) {
+			registry.SetLevel("", e.New.Level)
+			registry.SetLevels(e.New.Levels)
+		})
+
+		app.LoggerRegistry = registry
+		app.Logger = registry.Logger()
+		logger.SetDefaultRegistry(registry)
+		di.Register(app.Container, app.LoggerRegistry)
+		di.Register(app.Container, app.Logger)
+		app.configSections = append(app.configSections, config.Section{Name: "logger", Target: loggerConfig.Get()})
+	}
+
+	// Reporter
+	{
+		reporterConfig := &reporter.Config{}
+		if err := app.ConfigLoader.Decode(reporterConfig); err != nil {
+			return err
+		}
+
+		if app.Reporter == nil {
+			r, err := reporter.New(*reporterConfig, *app.BaseConfig)
+			if err != nil {
+				return err
+			}
+
+			app.Reporter = r
+		}
+
+		// отправить накопленные ошибки при остановке (до сброса логов)
+		app.AddStopHook(app.Reporter.Flush)
+
+		reporter.SetDefault(app.Reporter)
+		di.Register(app.Container, func() reporter.Reporter { return app.Reporter })
+		app.configSections = append(app.configSections, config.Section{Name: "reporter", Target: reporterConfig})
+	}
+
 	// Timezone
 	{
 		if app.BaseConfig != nil && app.BaseConfig.TimeZone != "" {
@@ -258,6 +461,17 @@ func (app *App) WaitForShutdown() {
 		}
 
 		log.Println("Application stopped gracefully.")
+
+		if app.logOutput != nil {
+			// Close ждёт очередь без учёта ctx, поэтому сначала Flush с таймаутом остановки
+			if err := app.logOutput.Flush(ctx); err != nil {
+				fmt.Fprintf(os.Stderr, "logger: flush error: %v\n", err)
+			}
+
+			if err := app.logOutput.Close(); err != nil {
+				fmt.Fprintf(os.Stderr, "logger: close error: %v\n", err)
+			}
+		}
 	})
 }
 
@@ -267,6 +481,8 @@ func (app *App) Fail(err error) {
 		return
 	}
 
+	app.reportError(err)
+
 	select {
 	case app.errCh <- err:
 	default:
@@ -276,3 +492,46 @@ func (app *App) Fail(err error) {
 		app.cancel()
 	}
 }
+
+// Recover перехватывает панику, пишет её в лог, отправляет в reporter и паникует дальше.
+//
+//	defer app.Recover()
+func (app *App) Recover() {
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+
+	app.reportError(exception.FromPanic(recovered))
+
+	if app.Reporter != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		_ = app.Reporter.Flush(ctx)
+		cancel()
+	}
+
+	panic(recovered)
+}
+
+// reportError пишет ошибку в лог и отправляет её в reporter (независимо от TrackInSentry).
+// AppException отправляется один раз: повторные вызовы для неё ничего не делают.
+func (app *App) reportError(err error) {
+	if ex, ok := exception.As(err); ok {
+		if ex.Reported() {
+			return
+		}
+
+		ex.MarkReported()
+	}
+
+	l := app.Logger
+	if l == nil {
+		l = logger.Default()
+	}
+
+	l.Error(err.Error(), logger.Err(err))
+
+	if app.Reporter != nil {
+		app.Reporter.Capture(app.ctx, err)
+	}
+}
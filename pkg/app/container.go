@@ -3,6 +3,9 @@ package app
 import (
 	"github.com/exgamer/gosdk-core/pkg/config"
 	"github.com/exgamer/gosdk-core/pkg/di"
+	"github.com/exgamer/gosdk-core/pkg/logger"
+	"github.com/exgamer/gosdk-core/pkg/reporter"
+	"log/slog"
 	"time"
 )
 
@@ -27,3 +30,58 @@ func GetBaseConfig(a *App) (*config.BaseConfig, error) {
 
 	return c, nil
 }
+
+// GetConfigLoader возвращает загрузчик конфигурации приложения.
+func GetConfigLoader(a *App) (*config.Loader, error) {
+	c, err := di.Resolve[*config.Loader](a.Container)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// GetLogger возвращает логгер приложения.
+func GetLogger(a *App) (*slog.Logger, error) {
+	c, err := di.Resolve[*slog.Logger](a.Container)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// GetLoggerRegistry возвращает реестр именованных логгеров (уровни по модулям).
+func GetLoggerRegistry(a *App) (*logger.Registry, error) {
+	c, err := di.Resolve[*logger.Registry](a.Container)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// GetReporter возвращает reporter ошибок (Sentry или no-op).
+func GetReporter(a *App) (reporter.Reporter, error) {
+	r, err := di.Resolve[reporter.Reporter](a.Container)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// GetModuleConfig возвращает секцию конфига модуля (по Name()), которая обновляется при перезагрузке конфига.
+func GetModuleConfig[T any](a *App, name string) (*config.Binding[T], error) {
+	section, err := di.ResolveNamed[*config.SectionBinding](a.Container, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return config.TypedBinding[T](section)
+}
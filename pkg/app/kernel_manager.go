@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/exgamer/gosdk-core/pkg/exception"
 	"sync"
 )
 
@@ -157,7 +158,7 @@ func (km *KernelManager) Run(app *App, name string) error {
 	st.startOnce.Do(func() {
 		defer close(st.startDone)
 
-		if err := k.Start(app); err != nil {
+		if err := startKernel(app, k); err != nil {
 			ctx, cancel := context.WithTimeout(app.ctx, app.shutdownTimeout)
 			defer cancel()
 			_ = k.Stop(ctx)
@@ -180,3 +181,16 @@ func (km *KernelManager) Run(app *App, name string) error {
 
 	return nil
 }
+
+// startKernel запускает kernel, паника в Start превращается в ошибку со стеком и сразу отправляется в reporter.
+// Ошибка отмечена как отправленная, поэтому App.Fail не отправит её повторно.
+func startKernel(app *App, k KernelInterface) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = exception.FromPanic(recovered)
+			app.reportError(err)
+		}
+	}()
+
+	return k.Start(app)
+}
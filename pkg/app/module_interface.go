@@ -4,3 +4,12 @@ type ModuleInterface interface {
 	Name() string
 	Init(a *App) error
 }
+
+// ConfigurableModuleInterface модуль со своей секцией конфигурации.
+// App загружает и валидирует секцию при регистрации модуля и регистрирует её в DI.
+// Секция перезагружается вместе с конфигом, актуальное значение и подписка на изменения - через GetModuleConfig.
+type ConfigurableModuleInterface interface {
+	ModuleInterface
+	// ConfigSection возвращает префикс переменных (например, "PAYMENTS_") и указатель на структуру конфига
+	ConfigSection() (prefix string, target any)
+}
@@ -0,0 +1,121 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/exgamer/gosdk-core/pkg/exception"
+	"sync"
+)
+
+// PanicPolicy Что делать, если горутина, запущенная через SafeGo или Group, упала с паникой или вернула ошибку
+type PanicPolicy int
+
+const (
+	// PanicFail записать в лог, отправить в reporter и остановить приложение (App.Fail)
+	PanicFail PanicPolicy = iota
+	// PanicReport только записать в лог и отправить в reporter, приложение продолжает работу
+	PanicReport
+)
+
+// SafeGo Запускает fn в горутине с context приложения (отменяется при остановке).
+// Паника превращается в AppException со стеком, ошибка (кроме отмены context) пишется в лог и отправляется в reporter,
+// после чего по App.PanicPolicy приложение останавливается (по умолчанию) или продолжает работу.
+func SafeGo(app *App, name string, fn func(ctx context.Context) error) {
+	ctx := appContext(app)
+
+	go func() {
+		handleGoroutineError(app, runSafe(ctx, name, fn))
+	}()
+}
+
+// handleGoroutineError применяет App.PanicPolicy к панике или ошибке горутины (отмена context игнорируется)
+func handleGoroutineError(app *App, err error) {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+
+	if app.PanicPolicy == PanicFail {
+		app.Fail(err)
+
+		return
+	}
+
+	app.reportError(err)
+}
+
+// appContext context приложения (до инициализации App - context.Background())
+func appContext(app *App) context.Context {
+	if ctx := app.GetContext(); ctx != nil {
+		return ctx
+	}
+
+	return context.Background()
+}
+
+// runSafe выполняет fn, паника возвращается как ошибка
+func runSafe(ctx context.Context, name string, fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("goroutine %s: %w", name, exception.FromPanic(recovered))
+		}
+	}()
+
+	if err := fn(ctx); err != nil {
+		return fmt.Errorf("goroutine %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// Group Группа горутин (как errgroup) с context приложения.
+// Первая ошибка или паника отменяет context группы, Wait возвращает первую ошибку.
+// Паники и ошибки (кроме отмены context) обрабатываются по App.PanicPolicy, как в SafeGo.
+type Group struct {
+	app    *App
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	once   sync.Once
+	err    error
+}
+
+func NewGroup(app *App) *Group {
+	ctx, cancel := context.WithCancel(appContext(app))
+
+	return &Group{app: app, ctx: ctx, cancel: cancel}
+}
+
+// Context Context группы: отменяется при остановке приложения или первой ошибке
+func (g *Group) Context() context.Context {
+	return g.ctx
+}
+
+// Go Запускает fn в горутине группы
+func (g *Group) Go(name string, fn func(ctx context.Context) error) {
+	g.wg.Add(1)
+
+	go func() {
+		defer g.wg.Done()
+
+		err := runSafe(g.ctx, name, fn)
+		if err == nil {
+			return
+		}
+
+		g.once.Do(func() {
+			g.err = err
+			g.cancel()
+		})
+
+		handleGoroutineError(g.app, err)
+	}()
+}
+
+// Wait Ждёт завершения всех горутин группы и возвращает первую ошибку
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel()
+
+	return g.err
+}
@@ -1,11 +1,14 @@
 package config
 
+import "time"
+
 // BaseConfig Основной конфиг приложения
 type BaseConfig struct {
-	Name          string `mapstructure:"APP_NAME" json:"app_name"`
-	ContainerName string `mapstructure:"CONTAINER_NAME" json:"container_name"`
-	AppEnv        string `mapstructure:"APP_ENV"    json:"app_env"`
-	Version       string `mapstructure:"APP_VERSION" json:"app_version"`
-	TimeZone      string `mapstructure:"TIMEZONE"    json:"timezone"`
-	Debug         bool   `mapstructure:"DEBUG"    json:"debug"`
+	Name                string        `mapstructure:"APP_NAME" json:"app_name" required:"true" desc:"Имя сервиса"`
+	ContainerName       string        `mapstructure:"CONTAINER_NAME" json:"container_name" desc:"Имя контейнера"`
+	AppEnv              Environment   `mapstructure:"APP_ENV"    json:"app_env" default:"development" desc:"Окружение: local, development, testing, staging, production"`
+	Version             string        `mapstructure:"APP_VERSION" json:"app_version" desc:"Версия сервиса"`
+	TimeZone            string        `mapstructure:"TIMEZONE"    json:"timezone" desc:"Таймзона, например Asia/Almaty"`
+	Debug               bool          `mapstructure:"DEBUG"    json:"debug" default:"false" desc:"Режим отладки"`
+	ConfigWatchInterval time.Duration `mapstructure:"CONFIG_WATCH_INTERVAL" json:"config_watch_interval" default:"0s" desc:"Интервал проверки файлов конфига, 0 - без опроса (SIGHUP работает всегда)"`
 }
@@ -1,61 +1,56 @@
 package config
 
-import (
-	"encoding/json"
-	"fmt"
-	structHelper "github.com/exgamer/gosdk-core/pkg/structures"
-	"github.com/spf13/viper"
-	"os"
-	"strings"
-)
-
-// ReadEnv Чтение переменок окружения
-func ReadEnv() error {
-	root, err := os.Getwd()
+import "sync/atomic"
 
-	if err != nil {
-		return err
-	}
+// std Загрузчик для функций уровня пакета (ReadEnv, ReadSources, InitConfig)
+var std atomic.Pointer[Loader]
 
-	viper.AddConfigPath(root)
-	viper.SetConfigType("env")
-	viper.SetConfigName(".env")
-	viper.AutomaticEnv()
-	err = viper.ReadInConfig()
+// ReadEnv Чтение конфигурации из источников по умолчанию (см. DefaultSources).
+// Файлы ищутся в DefaultConfigDir и не являются обязательными.
+//
+// Deprecated: используйте собственный Loader (NewDefaultLoader), App создаёт его сам.
+func ReadEnv() error {
+	return ReadSources(DefaultSources(DefaultConfigDir())...)
+}
+
+// ReadSources Чтение конфигурации из источников в порядке возрастания приоритета
+//
+// Deprecated: используйте собственный Loader (NewLoader).
+func ReadSources(sources ...Source) error {
+	loader := NewLoader(sources...)
 
-	if err != nil {
+	if err := loader.Load(); err != nil {
 		return err
 	}
 
+	std.Store(loader)
+
 	return nil
 }
 
-// InitConfig Инициализирует конфиг из переменок окружения
+// InitConfig Инициализирует конфиг из значений, загруженных ReadEnv / ReadSources.
+// Если они не вызывались, значения читаются из переменных окружения.
+//
+// Поддерживаемые теги полей:
+//
+//	mapstructure:"APP_NAME"      - имя переменной; для вложенной структуры - префикс (DB -> DB_HOST)
+//	default:"value"              - значение, если переменная не задана
+//	required:"true"              - переменная обязательна
+//	validate:"oneof=dev prod"    - правила go-playground/validator
+//
+// Возвращает *ValidationError со списком всех отсутствующих и некорректных переменных.
+//
+// Deprecated: используйте Loader.Decode / LoadSection.
 func InitConfig[E any](config *E) error {
-	err := viper.Unmarshal(config)
+	loader := std.Load()
+	if loader == nil {
+		// ReadEnv не вызывался - читаем хотя бы переменные окружения
+		loader = NewLoader(NewEnvSource())
 
-	if err != nil {
-		fmt.Println(err.Error())
-	}
-
-	envKeys := structHelper.GetFieldsAsMapStructureTags(config)
-	osEnvMap := make(map[string]any, len(envKeys))
-
-	for _, key := range envKeys {
-		if value, exists := os.LookupEnv(key); exists {
-			key = strings.ToLower(key)
-			osEnvMap[key] = value
+		if err := loader.Load(); err != nil {
+			return err
 		}
 	}
 
-	//	// Convert the map to JSON
-	jsonData, _ := json.Marshal(osEnvMap)
-	// Convert the JSON to a struct
-	uErr := json.Unmarshal(jsonData, &config)
-
-	if uErr != nil {
-		return uErr
-	}
-
-	return nil
+	return loader.Decode(config)
 }
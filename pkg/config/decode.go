@@ -0,0 +1,330 @@
+package config
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	envTag      = "mapstructure"
+	defaultTag  = "default"
+	requiredTag = "required"
+)
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	urlType             = reflect.TypeOf(url.URL{})
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// field Поле конфига, связанное с переменной окружения
+type field struct {
+	Env   string
+	Path  string
+	Field reflect.StructField
+	Value reflect.Value
+}
+
+func (f field) isRequired() bool {
+	return f.Field.Tag.Get(requiredTag) == "true"
+}
+
+// decode Заполняет структуру значениями из плоского набора переменных, проверяет default/required/validate
+func decode(values map[string]string, prefix string, target any) error {
+	if v := reflect.ValueOf(target); v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config: target must be a non-nil pointer to struct, got %T", target)
+	}
+
+	fields := collectFields(target, prefix)
+	verr := &ValidationError{}
+
+	for _, f := range fields {
+		raw, ok := values[f.Env]
+
+		// секрет может быть задан файлом: DB_PASSWORD_FILE=/run/secrets/db
+		if path := values[f.Env+fileSuffix]; path != "" && f.isSecret() {
+			if ok {
+				verr.add(f, "", "both %s and %s are set", f.Env, f.Env+fileSuffix)
+
+				continue
+			}
+
+			content, err := readSecretFile(path)
+			if err != nil {
+				verr.add(f, "", "%s: %s", f.Env+fileSuffix, err)
+
+				continue
+			}
+
+			raw, ok = content, true
+		}
+
+		if !ok {
+			raw, ok = f.Field.Tag.Lookup(defaultTag)
+		}
+
+		// map может быть задана и отдельными переменными с префиксом: LABELS_TEAM=core
+		if f.Value.Kind() == reflect.Map {
+			if err := decodeMap(f.Value, raw, prefixed(values, f.Env+"_")); err != nil {
+				verr.add(f, raw, "invalid value %q: %s", raw, err)
+			} else if f.isRequired() && f.Value.Len() == 0 {
+				verr.add(f, "", "required variable is not set")
+			}
+
+			continue
+		}
+
+		if !ok || strings.TrimSpace(raw) == "" {
+			if f.isRequired() {
+				verr.add(f, "", "required variable is not set")
+			}
+
+			continue
+		}
+
+		if err := decodeValue(f.Value, raw); err != nil {
+			verr.add(f, raw, "invalid value %q: %s", raw, err)
+		}
+	}
+
+	if err := validateFields(target, fields, verr); err != nil {
+		return err
+	}
+
+	return verr.orNil()
+}
+
+// collectFields Возвращает поля структуры, помеченные тегом mapstructure, включая поля вложенных структур
+func collectFields(target any, prefix string) []field {
+	return walkStruct(reflect.ValueOf(target).Elem(), prefix, "", nil)
+}
+
+// walkStruct Обходит структуру. Вложенная структура с тегом добавляет префикс к именам переменных
+// (mapstructure:"DB" -> DB_HOST), встроенная структура без тега или с ",squash" префикс не добавляет.
+func walkStruct(v reflect.Value, envPrefix string, pathPrefix string, out []field) []field {
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(sf.Tag.Get(envTag), ",")
+		if name == "-" {
+			continue
+		}
+
+		path := sf.Name
+		if pathPrefix != "" {
+			path = pathPrefix + "." + sf.Name
+		}
+
+		if isNested(sf.Type) {
+			squash := strings.Contains(opts, "squash")
+			if name == "" && !sf.Anonymous && !squash {
+				continue
+			}
+
+			prefix := envPrefix
+			if name != "" && !squash {
+				prefix = envPrefix + name + "_"
+			}
+
+			out = walkStruct(indirect(v.Field(i)), prefix, path, out)
+
+			continue
+		}
+
+		if name == "" {
+			continue
+		}
+
+		out = append(out, field{Env: envPrefix + name, Path: path, Field: sf, Value: v.Field(i)})
+	}
+
+	return out
+}
+
+// isNested Структура, поля которой нужно обходить (а не декодировать из одной строки)
+func isNested(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Struct &&
+		t != urlType &&
+		!reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// indirect Создаёт значение для nil-указателя и возвращает то, на что он указывает
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		v = v.Elem()
+	}
+
+	return v
+}
+
+// decodeValue Декодирует строку в значение поля с учётом типа
+func decodeValue(v reflect.Value, raw string) error {
+	v = indirect(v)
+	raw = strings.TrimSpace(raw)
+
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(raw))
+	}
+
+	switch v.Type() {
+	case durationType:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("expected duration like 300ms, 30s, 5m")
+		}
+
+		v.SetInt(int64(d))
+
+		return nil
+	case urlType:
+		u, err := url.Parse(raw)
+		if err != nil {
+			return fmt.Errorf("expected url: %w", err)
+		}
+
+		v.Set(reflect.ValueOf(*u))
+
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("expected bool")
+		}
+
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("expected %s", v.Type())
+		}
+
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("expected %s", v.Type())
+		}
+
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(raw, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("expected %s", v.Type())
+		}
+
+		v.SetFloat(n)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte(raw))
+
+			return nil
+		}
+
+		parts := splitList(raw)
+		slice := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+
+		for i, part := range parts {
+			if err := decodeValue(slice.Index(i), part); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+		}
+
+		v.Set(slice)
+	case reflect.Map:
+		return decodeMap(v, raw, nil)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	return nil
+}
+
+// decodeMap Декодирует map из строки "k1=v1,k2=v2" и из переменных с префиксом (ключ в нижнем регистре)
+func decodeMap(v reflect.Value, raw string, entries map[string]string) error {
+	if v.Type().Key().Kind() != reflect.String {
+		return fmt.Errorf("unsupported map key type %s", v.Type().Key())
+	}
+
+	pairs := make(map[string]string, len(entries))
+
+	for k, item := range entries {
+		pairs[strings.ToLower(k)] = item
+	}
+
+	for _, part := range splitList(raw) {
+		k, item, ok := strings.Cut(part, "=")
+		if !ok {
+			return fmt.Errorf("expected key=value pairs separated by comma")
+		}
+
+		pairs[strings.TrimSpace(k)] = item
+	}
+
+	if len(pairs) == 0 {
+		return nil
+	}
+
+	m := reflect.MakeMapWithSize(v.Type(), len(pairs))
+
+	for k, item := range pairs {
+		elem := reflect.New(v.Type().Elem()).Elem()
+		if err := decodeValue(elem, item); err != nil {
+			return fmt.Errorf("key %s: %w", k, err)
+		}
+
+		m.SetMapIndex(reflect.ValueOf(k).Convert(v.Type().Key()), elem)
+	}
+
+	v.Set(m)
+
+	return nil
+}
+
+// splitList Разбивает строку по запятым, пустые элементы отбрасываются
+func splitList(raw string) []string {
+	result := make([]string, 0)
+
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			result = append(result, part)
+		}
+	}
+
+	return result
+}
+
+// prefixed Возвращает значения с ключами, начинающимися с prefix (префикс отрезается)
+func prefixed(values map[string]string, prefix string) map[string]string {
+	result := make(map[string]string)
+
+	for k, v := range values {
+		if rest, ok := strings.CutPrefix(k, prefix); ok && rest != "" {
+			result[rest] = v
+		}
+	}
+
+	return result
+}
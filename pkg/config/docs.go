@@ -0,0 +1,167 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const descTag = "desc"
+
+// FieldInfo Описание переменной конфига
+type FieldInfo struct {
+	Section     string
+	Env         string
+	Type        string
+	Default     string
+	HasDefault  bool
+	Required    bool
+	Secret      bool
+	Description string
+}
+
+// Describe Описание всех переменных конфига (описание берётся из тега desc)
+func Describe(target any, prefix string) []FieldInfo {
+	fields := collectFields(target, prefix)
+	result := make([]FieldInfo, 0, len(fields))
+
+	for _, f := range fields {
+		def, hasDefault := f.Field.Tag.Lookup(defaultTag)
+
+		result = append(result, FieldInfo{
+			Env:         f.Env,
+			Type:        f.Field.Type.String(),
+			Default:     def,
+			HasDefault:  hasDefault,
+			Required:    f.isRequired(),
+			Secret:      f.isSecret(),
+			Description: f.Field.Tag.Get(descTag),
+		})
+	}
+
+	return result
+}
+
+// DescribeSections Описание переменных нескольких секций
+func DescribeSections(sections ...Section) []FieldInfo {
+	result := make([]FieldInfo, 0)
+
+	for _, section := range sections {
+		for _, info := range Describe(section.Target, section.Prefix) {
+			info.Section = section.Name
+			result = append(result, info)
+		}
+	}
+
+	return result
+}
+
+// Markdown Таблица переменных в формате Markdown
+func Markdown(fields []FieldInfo) string {
+	var b strings.Builder
+
+	b.WriteString("| Переменная | Тип | По умолчанию | Обязательная | Секрет | Описание |\n")
+	b.WriteString("|---|---|---|---|---|---|\n")
+
+	for _, f := range fields {
+		def := ""
+		if f.HasDefault {
+			def = "`" + f.Default + "`"
+		}
+
+		b.WriteString(fmt.Sprintf("| `%s` | `%s` | %s | %s | %s | %s |\n",
+			f.Env, f.Type, def, yesNo(f.Required), yesNo(f.Secret), escapeMarkdown(f.Description)))
+	}
+
+	return b.String()
+}
+
+// EnvExample Содержимое файла .env.example: переменные со значениями по умолчанию и описанием в комментарии
+func EnvExample(fields []FieldInfo) string {
+	var b strings.Builder
+
+	section := ""
+
+	for i, f := range fields {
+		if f.Section != section {
+			section = f.Section
+			if i > 0 {
+				b.WriteString("\n")
+			}
+
+			b.WriteString("# [" + section + "]\n")
+		}
+
+		comment := make([]string, 0, 3)
+		if f.Description != "" {
+			comment = append(comment, f.Description)
+		}
+
+		comment = append(comment, f.Type)
+
+		if f.Required {
+			comment = append(comment, "required")
+		}
+
+		b.WriteString("# " + strings.Join(comment, ", ") + "\n")
+
+		value := f.Default
+		if f.Secret {
+			value = ""
+		}
+
+		b.WriteString(f.Env + "=" + value + "\n")
+	}
+
+	return b.String()
+}
+
+func yesNo(v bool) string {
+	if v {
+		return "да"
+	}
+
+	return ""
+}
+
+func escapeMarkdown(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
+
+// UnknownKeys Переменные с префиксом секции, которым не соответствует ни одно поле (опечатки в именах).
+// Учитываются переменные map-полей (PREFIX_LABELS_*) и файлы полей-секретов (*_FILE).
+func UnknownKeys(values map[string]string, prefix string, target any) []string {
+	if prefix == "" {
+		return nil
+	}
+
+	fields := collectFields(target, prefix)
+	result := make([]string, 0)
+
+	for k := range values {
+		if !strings.HasPrefix(k, prefix) || isKnownKey(k, fields) {
+			continue
+		}
+
+		result = append(result, k)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
+
+func isKnownKey(key string, fields []field) bool {
+	for _, f := range fields {
+		if key == f.Env || (f.Value.Kind() == reflect.Map && strings.HasPrefix(key, f.Env+"_")) {
+			return true
+		}
+
+		if f.isSecret() && key == f.Env+fileSuffix {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Environment Окружение приложения (APP_ENV)
+type Environment string
+
+const (
+	EnvLocal       Environment = "local"
+	EnvDevelopment Environment = "development"
+	EnvTesting     Environment = "testing"
+	EnvStaging     Environment = "staging"
+	EnvProduction  Environment = "production"
+)
+
+// environmentAliases Допустимые написания окружений
+var environmentAliases = map[string]Environment{
+	"local":       EnvLocal,
+	"dev":         EnvDevelopment,
+	"develop":     EnvDevelopment,
+	"development": EnvDevelopment,
+	"test":        EnvTesting,
+	"testing":     EnvTesting,
+	"stage":       EnvStaging,
+	"staging":     EnvStaging,
+	"prod":        EnvProduction,
+	"production":  EnvProduction,
+}
+
+// DefaultEnvironment Окружение, если APP_ENV не задан (как default у BaseConfig.AppEnv)
+const DefaultEnvironment = EnvDevelopment
+
+// ParseEnvironment Разбирает окружение без учёта регистра, поддерживает сокращения (prod, dev, stage, test)
+func ParseEnvironment(value string) (Environment, error) {
+	if env, ok := environmentAliases[strings.ToLower(strings.TrimSpace(value))]; ok {
+		return env, nil
+	}
+
+	return "", fmt.Errorf("unknown environment %q, expected one of: local, development, testing, staging, production", value)
+}
+
+// UnmarshalText Используется при загрузке конфига: APP_ENV=prod -> production
+func (e *Environment) UnmarshalText(text []byte) error {
+	env, err := ParseEnvironment(string(text))
+	if err != nil {
+		return err
+	}
+
+	*e = env
+
+	return nil
+}
+
+func (e Environment) String() string {
+	return string(e)
+}
+
+func (e Environment) IsLocal() bool {
+	return e == EnvLocal
+}
+
+func (e Environment) IsDevelopment() bool {
+	return e == EnvDevelopment
+}
+
+func (e Environment) IsTesting() bool {
+	return e == EnvTesting
+}
+
+func (e Environment) IsStaging() bool {
+	return e == EnvStaging
+}
+
+func (e Environment) IsProduction() bool {
+	return e == EnvProduction
+}
+
+// Profile Поведение SDK, зависящее от окружения
+type Profile struct {
+	// DebugAllowed можно ли включать DEBUG
+	DebugAllowed bool
+	// DumpConfig выводить ли отчёт с конфигом при старте
+	DumpConfig bool
+	// StrictValidation неизвестные переменные с префиксом секции модуля - ошибка, а не предупреждение
+	StrictValidation bool
+}
+
+// Profile Профиль окружения: production - без debug и вывода конфига, staging и production - строгая валидация
+func (e Environment) Profile() Profile {
+	switch e {
+	case EnvProduction:
+		return Profile{DebugAllowed: false, DumpConfig: false, StrictValidation: true}
+	case EnvStaging:
+		return Profile{DebugAllowed: true, DumpConfig: true, StrictValidation: true}
+	default:
+		return Profile{DebugAllowed: true, DumpConfig: true, StrictValidation: false}
+	}
+}
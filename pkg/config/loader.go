@@ -0,0 +1,129 @@
+package config
+
+import (
+	"fmt"
+	"sync"
+)
+
+// NewLoader Загрузчик конфигурации с собственным набором источников (в порядке возрастания приоритета)
+func NewLoader(sources ...Source) *Loader {
+	return &Loader{
+		sources: sources,
+		values:  make(map[string]string),
+	}
+}
+
+// NewDefaultLoader Загрузчик с источниками по умолчанию (см. DefaultSources).
+// Секреты из файлов (*_FILE) читаются при декодировании полей-секретов.
+func NewDefaultLoader() *Loader {
+	return NewLoader(DefaultSources(DefaultConfigDir())...)
+}
+
+// Loader Загрузчик конфигурации. Хранит свои источники и загруженные значения,
+// поэтому несколько экземпляров не влияют друг на друга.
+type Loader struct {
+	mu        sync.RWMutex
+	sources   []Source
+	providers []SecretProvider
+	bindings  []binding
+	values    map[string]string
+}
+
+// AddSource Добавляет источник с наивысшим приоритетом. Значения применяются при следующем Load.
+func (l *Loader) AddSource(source Source) {
+	l.mu.Lock()
+	l.sources = append(l.sources, source)
+	l.mu.Unlock()
+}
+
+// AddSecretProvider Добавляет поставщика секретов. Секреты разрешаются при каждом Load после объединения источников.
+func (l *Loader) AddSecretProvider(provider SecretProvider) {
+	l.mu.Lock()
+	l.providers = append(l.providers, provider)
+	l.mu.Unlock()
+}
+
+// Load Читает все источники, объединяет значения и разрешает секреты
+func (l *Loader) Load() error {
+	values, err := l.read()
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	l.values = values
+	l.mu.Unlock()
+
+	return nil
+}
+
+// read Читает источники и разрешает секреты, не изменяя состояние загрузчика
+func (l *Loader) read() (map[string]string, error) {
+	l.mu.RLock()
+	sources := append([]Source(nil), l.sources...)
+	providers := append([]SecretProvider(nil), l.providers...)
+	l.mu.RUnlock()
+
+	values, err := MergeSources(sources...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, provider := range providers {
+		secrets, err := provider.Resolve(values)
+		if err != nil {
+			return nil, fmt.Errorf("secret provider %s: %w", provider.Name(), err)
+		}
+
+		for k, v := range secrets {
+			values[k] = v
+		}
+	}
+
+	return values, nil
+}
+
+// Lookup Возвращает загруженное значение переменной
+func (l *Loader) Lookup(key string) (string, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	v, ok := l.values[key]
+
+	return v, ok
+}
+
+// Values Возвращает копию всех загруженных значений
+func (l *Loader) Values() map[string]string {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	result := make(map[string]string, len(l.values))
+	for k, v := range l.values {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Decode Заполняет структуру загруженными значениями (см. InitConfig)
+func (l *Loader) Decode(target any) error {
+	return l.DecodeSection("", target)
+}
+
+// DecodeSection Заполняет структуру значениями с префиксом: для prefix "PAYMENTS_" поле
+// mapstructure:"API_URL" читается из PAYMENTS_API_URL
+func (l *Loader) DecodeSection(prefix string, target any) error {
+	return decode(l.Values(), prefix, target)
+}
+
+// LoadSection Создаёт и заполняет конфиг секции модуля
+func LoadSection[T any](l *Loader, prefix string) (*T, error) {
+	section := new(T)
+
+	if err := l.DecodeSection(prefix, section); err != nil {
+		return nil, err
+	}
+
+	return section, nil
+}
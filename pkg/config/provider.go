@@ -0,0 +1,228 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// providerLoadTimeout Таймаут загрузки конфигурации из Provider при Load/Reload
+const providerLoadTimeout = 10 * time.Second
+
+// Provider Поставщик удалённой конфигурации (Consul, etcd и тп).
+// Load возвращает плоские значения в формате переменных окружения,
+// Watch блокируется до отмены ctx и вызывает onChange при изменении данных.
+type Provider interface {
+	Name() string
+	Load(ctx context.Context) (map[string]string, error)
+	Watch(ctx context.Context, onChange func()) error
+}
+
+// NewProviderSource Источник на основе Provider. Встраивается в цепочку источников как обычный Source,
+// а Loader.Watch подписывается на его изменения.
+func NewProviderSource(provider Provider) Source {
+	return &providerSource{provider: provider}
+}
+
+type providerSource struct {
+	provider Provider
+}
+
+func (s *providerSource) Name() string {
+	return "provider:" + s.provider.Name()
+}
+
+func (s *providerSource) Load() (map[string]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), providerLoadTimeout)
+	defer cancel()
+
+	values, err := s.provider.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(values))
+	for k, v := range values {
+		result[normalizeKey(k)] = v
+	}
+
+	return result, nil
+}
+
+func (s *providerSource) watch(ctx context.Context, onChange func()) error {
+	return s.provider.Watch(ctx, onChange)
+}
+
+// NewDirectoryProvider Provider на основе директории с файлами-ключами: имя файла - ключ, содержимое - значение.
+// Вложенные директории дают составной ключ: db/primary/host -> DB_PRIMARY_HOST (как ключи в Consul KV).
+// Изменения проверяются раз в interval (interval <= 0 - не отслеживаются). Подходит для тестов и смонтированных ConfigMap.
+func NewDirectoryProvider(dir string, interval time.Duration) Provider {
+	return &directoryProvider{dir: dir, interval: interval}
+}
+
+type directoryProvider struct {
+	dir      string
+	interval time.Duration
+}
+
+func (p *directoryProvider) Name() string {
+	return "dir:" + p.dir
+}
+
+func (p *directoryProvider) Load(context.Context) (map[string]string, error) {
+	result := make(map[string]string)
+
+	err := filepath.WalkDir(p.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// скрытые файлы и директории (..data в Kubernetes) пропускаем
+		if path != p.dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(p.dir, path)
+		if err != nil {
+			return err
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		result[normalizeKey(strings.ReplaceAll(rel, string(filepath.Separator), "_"))] = strings.TrimRight(string(content), "\r\n")
+
+		return nil
+	})
+
+	return result, err
+}
+
+func (p *directoryProvider) Watch(ctx context.Context, onChange func()) error {
+	if p.interval <= 0 {
+		return nil
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	last, _ := p.Load(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			current, err := p.Load(ctx)
+			if err != nil || equalValues(current, last) {
+				continue
+			}
+
+			last = current
+			onChange()
+		}
+	}
+}
+
+// NewCachedProvider Сохраняет последнюю успешно загруженную конфигурацию в файл cachePath
+// и использует её, если Provider недоступен (старт без сети).
+func NewCachedProvider(provider Provider, cachePath string) Provider {
+	return &cachedProvider{provider: provider, cachePath: cachePath}
+}
+
+type cachedProvider struct {
+	provider  Provider
+	cachePath string
+}
+
+func (p *cachedProvider) Name() string {
+	return p.provider.Name()
+}
+
+func (p *cachedProvider) Load(ctx context.Context) (map[string]string, error) {
+	values, err := p.provider.Load(ctx)
+	if err == nil {
+		if cErr := p.save(values); cErr != nil {
+			log.Printf("Config cache write error: %v", cErr)
+		}
+
+		return values, nil
+	}
+
+	cached, cErr := p.load()
+	if cErr != nil {
+		return nil, errors.Join(err, fmt.Errorf("cache: %w", cErr))
+	}
+
+	log.Printf("Config provider %s unavailable, using cached config: %v", p.provider.Name(), err)
+
+	return cached, nil
+}
+
+func (p *cachedProvider) Watch(ctx context.Context, onChange func()) error {
+	return p.provider.Watch(ctx, onChange)
+}
+
+// save записывает кэш атомарно; файл может содержать секреты, поэтому доступен только владельцу
+func (p *cachedProvider) save(values map[string]string) error {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p.cachePath), 0o700); err != nil {
+		return err
+	}
+
+	tmp := p.cachePath + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, p.cachePath)
+}
+
+func (p *cachedProvider) load() (map[string]string, error) {
+	data, err := os.ReadFile(p.cachePath)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string)
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func equalValues(a map[string]string, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if bv, ok := b[k]; !ok || bv != v {
+			return false
+		}
+	}
+
+	return true
+}
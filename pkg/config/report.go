@@ -0,0 +1,82 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Section Секция конфигурации: имя (обычно имя модуля), префикс переменных и загруженная структура
+type Section struct {
+	Name   string
+	Prefix string
+	Target any
+}
+
+// Report Текстовый отчёт с действующими значениями всех секций, секреты замаскированы
+func Report(sections ...Section) string {
+	var b strings.Builder
+
+	b.WriteString("config:\n")
+
+	for _, section := range sections {
+		b.WriteString("  [" + section.Name + "]\n")
+
+		for _, f := range collectFields(section.Target, section.Prefix) {
+			b.WriteString(fmt.Sprintf("    %s = %s\n", f.Env, redactedValue(f)))
+		}
+	}
+
+	return b.String()
+}
+
+// formatValue Значение поля в том же формате, в котором оно задаётся в переменной окружения
+func formatValue(v reflect.Value) string {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+
+		v = v.Elem()
+	}
+
+	if v.CanInterface() {
+		if s, ok := v.Interface().(fmt.Stringer); ok {
+			return s.String()
+		}
+
+		if v.CanAddr() {
+			if s, ok := v.Addr().Interface().(fmt.Stringer); ok {
+				return s.String()
+			}
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			return string(v.Bytes())
+		}
+
+		parts := make([]string, v.Len())
+		for i := range parts {
+			parts[i] = formatValue(v.Index(i))
+		}
+
+		return strings.Join(parts, ",")
+	case reflect.Map:
+		parts := make([]string, 0, v.Len())
+
+		iter := v.MapRange()
+		for iter.Next() {
+			parts = append(parts, fmt.Sprint(iter.Key().Interface())+"="+formatValue(iter.Value()))
+		}
+
+		sort.Strings(parts)
+
+		return strings.Join(parts, ",")
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
@@ -0,0 +1,143 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// SecretMask Значение, которым заменяются секреты в отчётах, логах и JSON
+const SecretMask = "******"
+
+const secretTag = "secret"
+
+// Secret Строка с секретом (пароль, API-ключ). Маскируется при выводе через fmt, slog и json.
+// Настоящее значение доступно только через Value().
+type Secret string
+
+// Value Настоящее значение секрета
+func (s Secret) Value() string {
+	return string(s)
+}
+
+func (s Secret) String() string {
+	return mask(string(s))
+}
+
+func (s Secret) GoString() string {
+	return fmt.Sprintf("config.Secret(%q)", s.String())
+}
+
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// mask Маскирует непустое значение (пустое оставляем, чтобы было видно, что секрет не задан)
+func mask(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return SecretMask
+}
+
+// isSecret Поле помечено тегом secret:"true" или имеет тип Secret
+func (f field) isSecret() bool {
+	t := f.Field.Type
+	if t == nil {
+		return false
+	}
+
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return f.Field.Tag.Get(secretTag) == "true" || t == reflect.TypeOf(Secret(""))
+}
+
+// Redacted Действующие значения конфига по именам переменных, секреты замаскированы.
+// Подходит для вывода в лог и JSON.
+func Redacted(target any, prefix string) map[string]string {
+	fields := collectFields(target, prefix)
+	result := make(map[string]string, len(fields))
+
+	for _, f := range fields {
+		result[f.Env] = redactedValue(f)
+	}
+
+	return result
+}
+
+func redactedValue(f field) string {
+	value := formatValue(f.Value)
+	if f.isSecret() {
+		return mask(value)
+	}
+
+	return value
+}
+
+// SecretProvider Поставщик секретов. По загруженным значениям возвращает значения секретов,
+// которые перекрывают значения из источников.
+type SecretProvider interface {
+	Name() string
+	Resolve(values map[string]string) (map[string]string, error)
+}
+
+const fileSuffix = "_FILE"
+
+// readSecretFile Содержимое файла секрета без завершающего перевода строки
+func readSecretFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// NewFileSecretProvider Секреты из файлов по соглашению *_FILE для явно перечисленных переменных:
+// для keys "DB_PASSWORD" значение DB_PASSWORD_FILE=/run/secrets/db задаёт DB_PASSWORD содержимым файла.
+// Поля с тегом secret:"true" и типа Secret читают *_FILE при декодировании без поставщика.
+func NewFileSecretProvider(keys ...string) SecretProvider {
+	return &fileSecretProvider{keys: keys}
+}
+
+type fileSecretProvider struct {
+	keys []string
+}
+
+func (p *fileSecretProvider) Name() string {
+	return "file"
+}
+
+func (p *fileSecretProvider) Resolve(values map[string]string) (map[string]string, error) {
+	result := make(map[string]string)
+
+	for _, key := range p.keys {
+		path := values[key+fileSuffix]
+		if path == "" {
+			continue
+		}
+
+		if _, exists := values[key]; exists {
+			return nil, fmt.Errorf("both %s and %s are set", key, key+fileSuffix)
+		}
+
+		content, err := readSecretFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key+fileSuffix, err)
+		}
+
+		result[key] = content
+	}
+
+	return result, nil
+}
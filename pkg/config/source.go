@@ -0,0 +1,318 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"github.com/spf13/viper"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// EnvKey Переменная окружения, по которой выбирается overlay-файл конфигурации (config.<APP_ENV>.yaml)
+const EnvKey = "APP_ENV"
+
+// PrintConfigKey Флаг --print-config (или PRINT_CONFIG=true): вывести действующий конфиг и завершиться (App.PrintConfigRequested)
+const PrintConfigKey = "PRINT_CONFIG"
+
+// ConfigDirKey Переменная окружения с директорией файлов конфигурации
+const ConfigDirKey = "APP_CONFIG_DIR"
+
+// Source Источник конфигурации.
+// Возвращает плоский набор значений с ключами в формате переменных окружения (APP_NAME, DB_HOST).
+type Source interface {
+	Name() string
+	Load() (map[string]string, error)
+}
+
+// overlaySource источник, который зависит от окружения (APP_ENV), вычисленного по остальным источникам
+type overlaySource interface {
+	Source
+	loadFor(env string) (map[string]string, error)
+}
+
+// DefaultSources Источники по умолчанию в порядке возрастания приоритета:
+// config.yaml -> config.<APP_ENV>.yaml -> .env -> переменные окружения -> флаги командной строки.
+// Все файлы необязательные, относительные пути считаются от dir.
+func DefaultSources(dir string) []Source {
+	return []Source{
+		NewFileSource(filepath.Join(dir, "config.yaml"), true),
+		NewOverlayFileSource(filepath.Join(dir, "config.yaml"), true),
+		NewFileSource(filepath.Join(dir, ".env"), true),
+		NewEnvSource(),
+		NewFlagSource(os.Args[1:]),
+	}
+}
+
+// DefaultConfigDir Директория файлов конфигурации: APP_CONFIG_DIR, иначе директория исполняемого файла
+func DefaultConfigDir() string {
+	if dir, ok := os.LookupEnv(ConfigDirKey); ok && dir != "" {
+		return dir
+	}
+
+	if exe, err := os.Executable(); err == nil {
+		return filepath.Dir(exe)
+	}
+
+	return ""
+}
+
+// MergeSources Загружает источники и объединяет значения: каждый следующий источник перекрывает предыдущие
+func MergeSources(sources ...Source) (map[string]string, error) {
+	layers := make([]map[string]string, len(sources))
+
+	for i, source := range sources {
+		if _, ok := source.(overlaySource); ok {
+			continue
+		}
+
+		values, err := source.Load()
+		if err != nil {
+			return nil, fmt.Errorf("config source %s: %w", source.Name(), err)
+		}
+
+		layers[i] = values
+	}
+
+	// overlay-файлы выбираются по окружению, вычисленному по всем остальным источникам (prod -> production)
+	env := effectiveEnv(mergeLayers(layers)[EnvKey])
+
+	for i, source := range sources {
+		overlay, ok := source.(overlaySource)
+		if !ok {
+			continue
+		}
+
+		values, err := overlay.loadFor(env)
+		if err != nil {
+			return nil, fmt.Errorf("config source %s: %w", source.Name(), err)
+		}
+
+		layers[i] = values
+	}
+
+	return mergeLayers(layers), nil
+}
+
+func mergeLayers(layers []map[string]string) map[string]string {
+	result := make(map[string]string)
+
+	for _, layer := range layers {
+		for k, v := range layer {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// NewDefaultsSource Значения по умолчанию
+func NewDefaultsSource(values map[string]string) Source {
+	return &defaultsSource{values: values}
+}
+
+type defaultsSource struct {
+	values map[string]string
+}
+
+func (s *defaultsSource) Name() string {
+	return "defaults"
+}
+
+func (s *defaultsSource) Load() (map[string]string, error) {
+	result := make(map[string]string, len(s.values))
+
+	for k, v := range s.values {
+		result[normalizeKey(k)] = v
+	}
+
+	return result, nil
+}
+
+// NewFileSource Файл конфигурации. Формат определяется по расширению: yaml, yml, json, toml, env (.env)
+func NewFileSource(path string, optional bool) Source {
+	return &fileSource{path: path, optional: optional}
+}
+
+type fileSource struct {
+	path     string
+	optional bool
+}
+
+func (s *fileSource) Name() string {
+	return "file:" + s.path
+}
+
+func (s *fileSource) Load() (map[string]string, error) {
+	return readFile(s.path, s.optional)
+}
+
+// NewOverlayFileSource Файл конфигурации для текущего окружения: для config.yaml и APP_ENV=production (или prod)
+// читается config.production.yaml. Если APP_ENV не задан, используется окружение по умолчанию (config.development.yaml).
+func NewOverlayFileSource(path string, optional bool) Source {
+	return &overlayFileSource{path: path, optional: optional}
+}
+
+type overlayFileSource struct {
+	path     string
+	optional bool
+}
+
+func (s *overlayFileSource) Name() string {
+	return "overlay:" + s.path
+}
+
+func (s *overlayFileSource) Load() (map[string]string, error) {
+	return s.loadFor(effectiveEnv(os.Getenv(EnvKey)))
+}
+
+func (s *overlayFileSource) loadFor(env string) (map[string]string, error) {
+	env = strings.TrimSpace(env)
+	if env == "" {
+		return map[string]string{}, nil
+	}
+
+	return readFile(overlayPath(s.path, env), s.optional)
+}
+
+// effectiveEnv окружение так же, как его получит BaseConfig.AppEnv:
+// пустое - DefaultEnvironment, сокращения приводятся к полному имени (prod -> production)
+func effectiveEnv(raw string) string {
+	if strings.TrimSpace(raw) == "" {
+		return DefaultEnvironment.String()
+	}
+
+	if env, err := ParseEnvironment(raw); err == nil {
+		return env.String()
+	}
+
+	return strings.TrimSpace(raw)
+}
+
+// overlayPath config.yaml + production -> config.production.yaml
+func overlayPath(path string, env string) string {
+	ext := filepath.Ext(path)
+
+	return strings.TrimSuffix(path, ext) + "." + strings.TrimSpace(env) + ext
+}
+
+// NewEnvSource Переменные окружения процесса
+func NewEnvSource() Source {
+	return &envSource{}
+}
+
+type envSource struct{}
+
+func (s *envSource) Name() string {
+	return "env"
+}
+
+func (s *envSource) Load() (map[string]string, error) {
+	result := make(map[string]string)
+
+	for _, kv := range os.Environ() {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			result[k] = v
+		}
+	}
+
+	return result, nil
+}
+
+// NewFlagSource Флаги командной строки вида --app-name=value или --debug (true).
+// Имя флага приводится к формату переменной окружения: --db-host -> DB_HOST.
+// Остальные аргументы (в том числе позиционные: app --debug serve) игнорируются.
+func NewFlagSource(args []string) Source {
+	return &flagSource{args: args}
+}
+
+type flagSource struct {
+	args []string
+}
+
+func (s *flagSource) Name() string {
+	return "flags"
+}
+
+func (s *flagSource) Load() (map[string]string, error) {
+	result := make(map[string]string)
+
+	for _, arg := range s.args {
+		// после "--" идут только позиционные аргументы
+		if arg == "--" {
+			break
+		}
+
+		if !strings.HasPrefix(arg, "--") {
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		if !hasValue {
+			value = "true"
+		}
+
+		result[normalizeKey(name)] = value
+	}
+
+	return result, nil
+}
+
+// readFile читает файл конфигурации отдельным экземпляром viper и возвращает плоские значения
+func readFile(path string, optional bool) (map[string]string, error) {
+	if _, err := os.Stat(path); err != nil {
+		if optional && errors.Is(err, fs.ErrNotExist) {
+			return map[string]string{}, nil
+		}
+
+		return nil, err
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+
+	if filepath.Base(path) == ".env" || filepath.Ext(path) == ".env" {
+		v.SetConfigType("env")
+	}
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	flatten("", v.AllSettings(), result)
+
+	return result, nil
+}
+
+// flatten раскладывает вложенные значения в плоские ключи: db.primary.host -> DB_PRIMARY_HOST, списки через запятую
+func flatten(prefix string, value any, out map[string]string) {
+	switch v := value.(type) {
+	case map[string]any:
+		for k, item := range v {
+			key := normalizeKey(k)
+			if prefix != "" {
+				key = prefix + "_" + key
+			}
+
+			flatten(key, item, out)
+		}
+	case []any:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = fmt.Sprint(item)
+		}
+
+		out[prefix] = strings.Join(parts, ",")
+	case nil:
+		out[prefix] = ""
+	default:
+		out[prefix] = fmt.Sprint(v)
+	}
+}
+
+// normalizeKey приводит ключ к формату переменной окружения: db-host, db.host -> DB_HOST
+func normalizeKey(key string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(strings.TrimSpace(key)))
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-playground/validator/v10"
+	"strconv"
+	"strings"
+)
+
+// ValidationError Ошибка загрузки конфига со списком всех отсутствующих и некорректных переменных
+type ValidationError struct {
+	Fields []FieldError
+}
+
+// FieldError Проблема с конкретной переменной окружения
+type FieldError struct {
+	Env     string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	lines := make([]string, 0, len(e.Fields)+1)
+	lines = append(lines, "invalid config:")
+
+	for _, f := range e.Fields {
+		lines = append(lines, fmt.Sprintf("  - %s: %s", f.Env, f.Message))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// add добавляет проблему с полем. Для секретов значение raw в сообщении маскируется.
+func (e *ValidationError) add(f field, raw string, format string, args ...any) {
+	message := fmt.Sprintf(format, args...)
+	if f.isSecret() && raw != "" {
+		message = strings.ReplaceAll(message, strconv.Quote(raw), strconv.Quote(SecretMask))
+		message = strings.ReplaceAll(message, raw, SecretMask)
+	}
+
+	e.Fields = append(e.Fields, FieldError{Env: f.Env, Message: message})
+}
+
+// orNil возвращает nil, если проблем нет (чтобы не получить non-nil error с nil-указателем)
+func (e *ValidationError) orNil() error {
+	if len(e.Fields) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// validateFields Проверка правил validate (go-playground/validator), ошибки привязываются к именам переменных
+func validateFields(target any, fields []field, verr *ValidationError) error {
+	byPath := make(map[string]field, len(fields))
+	for _, f := range fields {
+		byPath[f.Path] = f
+	}
+
+	err := validator.New().Struct(target)
+	if err == nil {
+		return nil
+	}
+
+	var ve validator.ValidationErrors
+	if !errors.As(err, &ve) {
+		return err
+	}
+
+	// по уже отмеченным переменным повторно не сообщаем
+	reported := make(map[string]bool, len(verr.Fields))
+	for _, f := range verr.Fields {
+		reported[f.Env] = true
+	}
+
+	for _, fe := range ve {
+		// StructNamespace: BaseConfig.Field.Sub -> Field.Sub
+		path := fe.StructNamespace()
+		if _, rest, ok := strings.Cut(path, "."); ok {
+			path = rest
+		}
+
+		f, ok := byPath[path]
+		if !ok {
+			f = field{Env: path}
+		}
+
+		if reported[f.Env] {
+			continue
+		}
+
+		rule := fe.Tag()
+		if fe.Param() != "" {
+			rule += "=" + fe.Param()
+		}
+
+		raw := fmt.Sprint(fe.Value())
+		verr.add(f, raw, "invalid value %q: failed on '%s' rule", raw, rule)
+	}
+
+	return nil
+}
@@ -0,0 +1,326 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// Change Изменение одного поля конфига
+type Change struct {
+	Env string
+	Old any
+	New any
+}
+
+// ChangeEvent Уведомление об изменении секции: старое и новое значение конфига и список изменённых полей
+type ChangeEvent[T any] struct {
+	Old     *T
+	New     *T
+	Changes []Change
+}
+
+// binding Секция, которая пересобирается при перезагрузке конфигурации
+type binding interface {
+	// prepare декодирует и валидирует секцию по новым значениям; commit применяет изменения и уведомляет подписчиков
+	prepare(values map[string]string) (commit func(), err error)
+}
+
+// watchedSource Источник, файлы которого отслеживаются при Watch
+type watchedSource interface {
+	files(values map[string]string) []string
+}
+
+// notifyingSource Источник, который сам сообщает об изменениях (Provider)
+type notifyingSource interface {
+	watch(ctx context.Context, onChange func()) error
+}
+
+func (s *fileSource) files(map[string]string) []string {
+	return []string{s.path}
+}
+
+func (s *overlayFileSource) files(values map[string]string) []string {
+	return []string{overlayPath(s.path, effectiveEnv(values[EnvKey]))}
+}
+
+// SectionEvent Уведомление об изменении секции без параметра типа: Old и New - указатели на конфиг секции
+type SectionEvent struct {
+	Old     any
+	New     any
+	Changes []Change
+}
+
+// SectionBinding Секция конфигурации, которая обновляется при перезагрузке Loader.
+// Нетипизированный вариант Binding - для секций, тип которых известен только во время выполнения (секции модулей).
+type SectionBinding struct {
+	prefix      string
+	typ         reflect.Type
+	current     atomic.Value
+	mu          sync.Mutex
+	subscribers []func(SectionEvent)
+}
+
+// BindSection Заполняет target (указатель на структуру) и подписывает секцию на перезагрузки загрузчика.
+// Сам target после этого не меняется: новые значения создаются заново и доступны через Get.
+// Если новые значения не проходят валидацию, обновление отклоняется и остаётся последний корректный конфиг.
+func (l *Loader) BindSection(prefix string, target any) (*SectionBinding, error) {
+	if err := l.DecodeSection(prefix, target); err != nil {
+		return nil, err
+	}
+
+	b := &SectionBinding{prefix: prefix, typ: reflect.TypeOf(target).Elem()}
+	b.current.Store(target)
+
+	l.mu.Lock()
+	l.bindings = append(l.bindings, b)
+	l.mu.Unlock()
+
+	return b, nil
+}
+
+// Get Текущий конфиг секции (указатель на структуру). Возвращаемое значение нельзя изменять.
+func (b *SectionBinding) Get() any {
+	return b.current.Load()
+}
+
+// Subscribe Подписка на изменения секции. Вызывается только если хотя бы одно поле изменилось.
+func (b *SectionBinding) Subscribe(fn func(SectionEvent)) {
+	b.mu.Lock()
+	b.subscribers = append(b.subscribers, fn)
+	b.mu.Unlock()
+}
+
+func (b *SectionBinding) prepare(values map[string]string) (func(), error) {
+	next := reflect.New(b.typ).Interface()
+	if err := decode(values, b.prefix, next); err != nil {
+		return nil, err
+	}
+
+	prev := b.current.Load()
+	changes := diff(prev, next, b.prefix)
+
+	return func() {
+		if len(changes) == 0 {
+			return
+		}
+
+		b.current.Store(next)
+
+		b.mu.Lock()
+		subscribers := make([]func(SectionEvent), len(b.subscribers))
+		copy(subscribers, b.subscribers)
+		b.mu.Unlock()
+
+		event := SectionEvent{Old: prev, New: next, Changes: changes}
+		for _, fn := range subscribers {
+			fn(event)
+		}
+	}, nil
+}
+
+// Binding Типизированная секция конфигурации, которая обновляется при перезагрузке Loader
+type Binding[T any] struct {
+	section *SectionBinding
+}
+
+// Bind Загружает секцию и подписывает её на перезагрузки загрузчика.
+// Если новые значения не проходят валидацию, обновление отклоняется и остаётся последний корректный конфиг.
+func Bind[T any](l *Loader, prefix string) (*Binding[T], error) {
+	section, err := l.BindSection(prefix, new(T))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Binding[T]{section: section}, nil
+}
+
+// TypedBinding Типизированный доступ к секции, привязанной через BindSection
+func TypedBinding[T any](section *SectionBinding) (*Binding[T], error) {
+	if typ := reflect.TypeOf((*T)(nil)).Elem(); section.typ != typ {
+		return nil, fmt.Errorf("config binding: section is %s, not %s", section.typ, typ)
+	}
+
+	return &Binding[T]{section: section}, nil
+}
+
+// Get Текущий конфиг секции. Возвращаемое значение нельзя изменять.
+func (b *Binding[T]) Get() *T {
+	return b.section.Get().(*T)
+}
+
+// Subscribe Подписка на изменения секции. Вызывается только если хотя бы одно поле изменилось.
+func (b *Binding[T]) Subscribe(fn func(ChangeEvent[T])) {
+	b.section.Subscribe(func(e SectionEvent) {
+		fn(ChangeEvent[T]{Old: e.Old.(*T), New: e.New.(*T), Changes: e.Changes})
+	})
+}
+
+// diff Список полей, значения которых отличаются
+func diff(prev any, next any, prefix string) []Change {
+	oldFields := collectFields(prev, prefix)
+	newFields := collectFields(next, prefix)
+	changes := make([]Change, 0)
+
+	for i := range newFields {
+		o, n := oldFields[i].Value.Interface(), newFields[i].Value.Interface()
+		if !reflect.DeepEqual(o, n) {
+			changes = append(changes, Change{Env: newFields[i].Env, Old: o, New: n})
+		}
+	}
+
+	return changes
+}
+
+// Reload Перечитывает источники и пересобирает все секции (Bind).
+// Если хотя бы одна секция не проходит валидацию, обновление отклоняется целиком.
+func (l *Loader) Reload() error {
+	values, err := l.read()
+	if err != nil {
+		return err
+	}
+
+	l.mu.RLock()
+	bindings := append([]binding(nil), l.bindings...)
+	l.mu.RUnlock()
+
+	commits := make([]func(), 0, len(bindings))
+	errs := make([]error, 0)
+
+	for _, b := range bindings {
+		commit, err := b.prepare(values)
+		if err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		commits = append(commits, commit)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("config reload rejected: %w", errors.Join(errs...))
+	}
+
+	l.mu.Lock()
+	l.values = values
+	l.mu.Unlock()
+
+	for _, commit := range commits {
+		commit()
+	}
+
+	return nil
+}
+
+// Watch Перезагружает конфиг при получении SIGHUP (перечитываются и переменные окружения),
+// при изменениях Provider-источников и, если interval > 0, при изменении файлов источников (проверка раз в interval).
+// Работает до отмены ctx.
+func (l *Loader) Watch(ctx context.Context, interval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	changed := make(chan string, 1)
+
+	l.mu.RLock()
+	sources := append([]Source(nil), l.sources...)
+	l.mu.RUnlock()
+
+	for _, source := range sources {
+		ns, ok := source.(notifyingSource)
+		if !ok {
+			continue
+		}
+
+		go func(name string) {
+			err := ns.watch(ctx, func() {
+				select {
+				case changed <- name:
+				default:
+				}
+			})
+
+			if err != nil {
+				log.Printf("Config watch %s error: %v", name, err)
+			}
+		}(source.Name())
+	}
+
+	go func() {
+		defer signal.Stop(hup)
+
+		// без interval файлы не опрашиваются: nil-канал никогда не сработает
+		var tick <-chan time.Time
+		if interval > 0 {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			tick = ticker.C
+		}
+
+		modTimes := l.modTimes()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-hup:
+				log.Println("Reloading config (SIGHUP)...")
+			case name := <-changed:
+				log.Printf("Reloading config (%s changed)...", name)
+			case <-tick:
+				current := l.modTimes()
+				if reflect.DeepEqual(current, modTimes) {
+					continue
+				}
+
+				modTimes = current
+				log.Println("Reloading config (files changed)...")
+			}
+
+			if err := l.Reload(); err != nil {
+				log.Printf("Config reload error: %v", err)
+			}
+
+			modTimes = l.modTimes()
+		}
+	}()
+}
+
+// modTimes Время изменения отслеживаемых файлов (отсутствующий файл - нулевое время)
+func (l *Loader) modTimes() map[string]time.Time {
+	l.mu.RLock()
+	sources := append([]Source(nil), l.sources...)
+	values := l.values
+	l.mu.RUnlock()
+
+	result := make(map[string]time.Time)
+
+	for _, source := range sources {
+		ws, ok := source.(watchedSource)
+		if !ok {
+			continue
+		}
+
+		for _, path := range ws.files(values) {
+			info, err := os.Stat(path)
+			if err != nil {
+				result[path] = time.Time{}
+
+				continue
+			}
+
+			result[path] = info.ModTime()
+		}
+	}
+
+	return result
+}
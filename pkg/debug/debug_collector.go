@@ -2,7 +2,9 @@ package debug
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sync"
 	"time"
 )
 
@@ -46,14 +48,21 @@ func NewDebugCollector() *DebugCollector {
 	}
 }
 
+// DebugCollector безопасен для использования из нескольких горутин (параллельные запросы в БД/HTTP).
+// Поля нужно менять только через методы, читать во время работы запроса - через Snapshot.
 type DebugCollector struct {
 	Meta      map[string]any       `json:"meta,omitempty"`
 	TotalTime string               `json:"total_time,omitempty"`
 	Steps     []string             `json:"steps,omitempty"`
 	Time      map[string]any       `json:"time,omitempty"`
 	Cats      map[string]*Category `json:"cats,omitempty"` // <-- модульно, без SQL/HTTP
+	// Spans дерево span (StartSpan), заполняется в Snapshot
+	Spans []*Span `json:"spans,omitempty"`
 
 	Start time.Time `json:"-"`
+
+	mu    sync.Mutex
+	spans []*Span
 }
 
 type Category struct {
@@ -64,8 +73,16 @@ type Category struct {
 	Duration time.Duration `json:"-"`
 }
 
-// Cat возвращает категорию (создаёт при отсутствии)
+// Cat возвращает копию категории (создаёт категорию при отсутствии).
+// Копию можно читать, пока другие горутины пишут в collector; изменения - через AddStatement.
 func (d *DebugCollector) Cat(name string) *Category {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.cat(name).copy()
+}
+
+func (d *DebugCollector) cat(name string) *Category {
 	if d.Cats == nil {
 		d.Cats = map[string]*Category{}
 	}
@@ -77,25 +94,110 @@ func (d *DebugCollector) Cat(name string) *Category {
 	return c
 }
 
+// copy копия категории со своим списком statements
+func (c *Category) copy() *Category {
+	return &Category{
+		TotalTime:  c.TotalTime,
+		Count:      c.Count,
+		Statements: append([]any(nil), c.Statements...),
+		Duration:   c.Duration,
+	}
+}
+
 // AddStep добавляет шаг (удобно для бизнес-логики)
 func (d *DebugCollector) AddStep(step string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
 	d.Steps = append(d.Steps, step)
 }
 
 // AddStatement добавляет statement в категорию и обновляет total по категории
 func (d *DebugCollector) AddStatement(cat string, duration time.Duration, stmt any) {
-	c := d.Cat(cat)
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c := d.cat(cat)
 	c.Statements = append(c.Statements, stmt)
 	c.Duration += duration
 	c.Count++
 	c.TotalTime = d.getDurationAsString(c.Duration)
 }
 
+// SetMeta добавляет метаданные запроса
+func (d *DebugCollector) SetMeta(key string, value any) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.Meta == nil {
+		d.Meta = map[string]any{}
+	}
+	d.Meta[key] = value
+}
+
+// SetTime добавляет произвольное время (Time)
+func (d *DebugCollector) SetTime(key string, value any) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.Time == nil {
+		d.Time = map[string]any{}
+	}
+	d.Time[key] = value
+}
+
 func (d *DebugCollector) CalculateTotalTime() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
 	execTime := time.Since(d.Start)
 	d.TotalTime = d.getDurationAsString(execTime)
 }
 
+// Snapshot копия текущего состояния (если CalculateTotalTime ещё не вызывался, TotalTime - на момент вызова).
+// Можно рендерить, пока другие горутины продолжают писать в collector.
+func (d *DebugCollector) Snapshot() *DebugCollector {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	snapshot := &DebugCollector{
+		Meta:      make(map[string]any, len(d.Meta)),
+		TotalTime: d.TotalTime,
+		Steps:     append([]string(nil), d.Steps...),
+		Time:      make(map[string]any, len(d.Time)),
+		Cats:      make(map[string]*Category, len(d.Cats)),
+		Spans:     d.spanTree(),
+		Start:     d.Start,
+		spans:     d.spanCopies(),
+	}
+
+	// запрос ещё выполняется - время на текущий момент
+	if snapshot.TotalTime == "" && !d.Start.IsZero() {
+		snapshot.TotalTime = d.getDurationAsString(time.Since(d.Start))
+	}
+
+	for k, v := range d.Meta {
+		snapshot.Meta[k] = v
+	}
+
+	for k, v := range d.Time {
+		snapshot.Time[k] = v
+	}
+
+	for name, c := range d.Cats {
+		snapshot.Cats[name] = c.copy()
+	}
+
+	return snapshot
+}
+
+// MarshalJSON JSON снимка состояния (безопасно во время записи из других горутин)
+func (d *DebugCollector) MarshalJSON() ([]byte, error) {
+	type plain DebugCollector
+
+	return json.Marshal((*plain)(d.Snapshot()))
+}
+
 // getDurationAsString - Duration в виде строки (s/ms/µs/ns)
 func (d *DebugCollector) getDurationAsString(duration time.Duration) string {
 	if duration >= time.Second {
@@ -0,0 +1,159 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// безопасный ключ текущего span в context
+type spanCtxKey struct{}
+
+// Span Шаг бизнес-логики с временем начала (от начала запроса) и длительностью.
+// В JSON коллектора spans выводятся деревом: вложенные шаги - в children.
+type Span struct {
+	Name       string         `json:"name"`
+	Start      string         `json:"start"`
+	Duration   string         `json:"duration,omitempty"`
+	InProgress bool           `json:"in_progress,omitempty"`
+	Attributes map[string]any `json:"attributes,omitempty"`
+	Children   []*Span        `json:"children,omitempty"`
+
+	ID          int           `json:"-"`
+	ParentID    int           `json:"-"`
+	StartOffset time.Duration `json:"-"`
+	Elapsed     time.Duration `json:"-"`
+}
+
+// StartSpan Начинает span в коллекторе из ctx. Родитель - span, начатый выше по ctx.
+// Атрибуты - пары ключ, значение (как у slog). Возвращает ctx для вложенных span и функцию завершения,
+// которой можно передать дополнительные атрибуты. Без коллектора в ctx ничего не делает.
+//
+//	ctx, end := debug.StartSpan(ctx, "load-user", "user_id", id)
+//	defer end()
+func StartSpan(ctx context.Context, name string, attrs ...any) (context.Context, func(attrs ...any)) {
+	dbg := GetDebugFromContext(ctx)
+	if dbg == nil {
+		return ctx, func(...any) {}
+	}
+
+	parentID, _ := ctx.Value(spanCtxKey{}).(int)
+	id := dbg.startSpan(parentID, name, attrs)
+
+	return context.WithValue(ctx, spanCtxKey{}, id), func(attrs ...any) {
+		dbg.endSpan(id, attrs)
+	}
+}
+
+func (d *DebugCollector) startSpan(parentID int, name string, attrs []any) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	span := &Span{
+		Name:        name,
+		ID:          len(d.spans) + 1,
+		ParentID:    parentID,
+		StartOffset: time.Since(d.Start),
+		InProgress:  true,
+	}
+	span.Start = d.getDurationAsString(span.StartOffset)
+	span.Attributes = addAttributes(span.Attributes, attrs)
+
+	d.spans = append(d.spans, span)
+
+	return span.ID
+}
+
+func (d *DebugCollector) endSpan(id int, attrs []any) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	span := d.spans[id-1]
+	if !span.InProgress {
+		return
+	}
+
+	span.Elapsed = time.Since(d.Start) - span.StartOffset
+	span.Duration = d.getDurationAsString(span.Elapsed)
+	span.InProgress = false
+	span.Attributes = addAttributes(span.Attributes, attrs)
+}
+
+// spanCopies копии span списком (для Snapshot: снимок снимка сохраняет span)
+func (d *DebugCollector) spanCopies() []*Span {
+	if len(d.spans) == 0 {
+		return nil
+	}
+
+	result := make([]*Span, 0, len(d.spans))
+
+	for _, s := range d.spans {
+		span := *s
+		span.Attributes = make(map[string]any, len(s.Attributes))
+		for k, v := range s.Attributes {
+			span.Attributes[k] = v
+		}
+
+		result = append(result, &span)
+	}
+
+	return result
+}
+
+// spanTree копии span деревом; у незавершённых длительность - на текущий момент
+func (d *DebugCollector) spanTree() []*Span {
+	if len(d.spans) == 0 {
+		return nil
+	}
+
+	now := time.Since(d.Start)
+	byID := make(map[int]*Span, len(d.spans))
+	roots := make([]*Span, 0)
+
+	for _, s := range d.spans {
+		span := *s
+		span.Attributes = make(map[string]any, len(s.Attributes))
+		for k, v := range s.Attributes {
+			span.Attributes[k] = v
+		}
+
+		if span.InProgress {
+			span.Elapsed = now - span.StartOffset
+			span.Duration = d.getDurationAsString(span.Elapsed)
+		}
+
+		byID[span.ID] = &span
+
+		if parent, ok := byID[span.ParentID]; ok {
+			parent.Children = append(parent.Children, &span)
+		} else {
+			roots = append(roots, &span)
+		}
+	}
+
+	return roots
+}
+
+// addAttributes добавляет пары ключ, значение; ключ не строка - fmt.Sprint, значение без ключа - "!BADKEY"
+func addAttributes(attributes map[string]any, attrs []any) map[string]any {
+	if attributes == nil {
+		attributes = make(map[string]any, len(attrs)/2)
+	}
+
+	for i := 0; i < len(attrs); i += 2 {
+		if i+1 >= len(attrs) {
+			attributes["!BADKEY"] = attrs[i]
+
+			break
+		}
+
+		key, ok := attrs[i].(string)
+		if !ok {
+			key = fmt.Sprint(attrs[i])
+		}
+
+		attributes[key] = attrs[i+1]
+	}
+
+	return attributes
+}
@@ -2,75 +2,193 @@ package di
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 	"sync"
 )
 
+var (
+	// ErrNotFound - зависимость не зарегистрирована в контейнере
+	ErrNotFound = errors.New("dependency not found")
+	// ErrWrongType - зарегистрированная фабрика не подходит для разрешения
+	ErrWrongType = errors.New("wrong dependency type")
+)
+
 // NewContainer - конструктор контейнера
 func NewContainer() *Container {
 	return &Container{
-		instances: make(map[reflect.Type]interface{}),
-		functions: make(map[reflect.Type]interface{}),
+		instances:  make(map[key]interface{}),
+		functions:  make(map[key]interface{}),
+		decorators: make(map[key][]decorator),
+		decorated:  make(map[key]interface{}),
 	}
 }
 
 // Container - контейнер зависимостей с поддержкой фабрик
 type Container struct {
-	mu        sync.RWMutex
-	instances map[reflect.Type]interface{}
-	functions map[reflect.Type]interface{}
+	mu           sync.RWMutex
+	instances    map[key]interface{}
+	functions    map[key]interface{}
+	decorators   map[key][]decorator
+	decorated    map[key]interface{}
+	interceptors []Interceptor
 }
 
-// Register - регистрирует зависимость (структуру или указатель)
+// key - ключ зависимости: тип и необязательное имя
+type key struct {
+	typ  reflect.Type
+	name string
+}
+
+func (k key) String() string {
+	if k.name == "" {
+		return k.typ.String()
+	}
+
+	return k.typ.String() + " (" + k.name + ")"
+}
+
+// Register - регистрирует зависимость (структуру или указатель) под типом T.
+// Реализацию можно зарегистрировать под интерфейсом: Register[Repo](c, impl).
 func Register[T any](c *Container, instance T) {
-	typ := reflect.TypeOf(instance)
+	c.register(reflect.TypeOf((*T)(nil)).Elem(), "", instance)
+}
+
+// RegisterNamed - регистрирует зависимость под именем (несколько зависимостей одного типа)
+func RegisterNamed[T any](c *Container, name string, instance T) {
+	c.register(reflect.TypeOf((*T)(nil)).Elem(), name, instance)
+}
+
+// Resolve - получает зависимость, используя дженерики (Go 1.18+)
+func Resolve[T any](c *Container) (T, error) {
+	return ResolveNamed[T](c, "")
+}
+
+// ResolveNamed - получает зависимость, зарегистрированную под именем
+func ResolveNamed[T any](c *Container, name string) (T, error) {
+	var zero T
+	typ := reflect.TypeOf((*T)(nil)).Elem() // Универсальный тип
+
+	instance, err := c.resolve(typ, name)
+	if err != nil {
+		return zero, err
+	}
+
+	if instance == nil {
+		return zero, nil
+	}
+
+	result, ok := instance.(T)
+	if !ok {
+		return zero, fmt.Errorf("%w: %s is %T", ErrWrongType, key{typ, name}, instance)
+	}
+
+	return result, nil
+}
+
+func (c *Container) register(typ reflect.Type, name string, instance any) {
+	// для any ключом остаётся фактический тип значения
+	if typ.Kind() == reflect.Interface && typ.NumMethod() == 0 {
+		typ = reflect.TypeOf(instance)
+	}
 
 	// Если это функция, регистрируем как конструктор
 	if typ.Kind() == reflect.Func {
 		outType := typ.Out(0) // Первый возвращаемый тип
+		k := key{outType, name}
 		c.mu.Lock()
-		c.functions[outType] = instance
+		c.functions[k] = instance
+		delete(c.instances, k)
+		delete(c.decorated, k)
 		c.mu.Unlock()
 
 		return
 	}
 
+	k := key{typ, name}
 	c.mu.Lock()
-	c.instances[typ] = instance
+	c.instances[k] = instance
+	delete(c.functions, k)
+	delete(c.decorated, k)
 	c.mu.Unlock()
 }
 
-// Resolve - получает зависимость, используя дженерики (Go 1.18+)
-func Resolve[T any](c *Container) (T, error) {
-	var zero T
-	typ := reflect.TypeOf((*T)(nil)).Elem() // Универсальный тип
+// resolve - возвращает зависимость с применёнными декораторами и перехватчиками
+func (c *Container) resolve(typ reflect.Type, name string) (any, error) {
+	k := key{typ, name}
+
+	c.mu.RLock()
+	if instance, exists := c.decorated[k]; exists {
+		c.mu.RUnlock()
+
+		return instance, nil
+	}
+	c.mu.RUnlock()
+
+	instance, err := c.resolveRaw(k)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	decorators := append([]decorator(nil), c.decorators[k]...)
+	interceptors := append([]Interceptor(nil), c.interceptors...)
+	c.mu.RUnlock()
+
+	if len(decorators) == 0 && len(interceptors) == 0 {
+		return instance, nil
+	}
+
+	// декораторы вызываются без блокировки, чтобы они могли разрешать свои зависимости
+	for _, decorate := range decorators {
+		if instance, err = decorate(c, instance); err != nil {
+			return nil, fmt.Errorf("decorate %s: %w", k, err)
+		}
+	}
+
+	for _, intercept := range interceptors {
+		if instance, err = intercept(typ, name, instance); err != nil {
+			return nil, fmt.Errorf("intercept %s: %w", k, err)
+		}
+	}
+
+	c.mu.Lock()
+	c.decorated[k] = instance
+	c.mu.Unlock()
+
+	return instance, nil
+}
+
+// resolveRaw - возвращает зависимость в том виде, в котором она зарегистрирована
+func (c *Container) resolveRaw(k key) (any, error) {
 	c.mu.RLock()
 
 	// Проверяем, есть ли готовый объект
-	if instance, exists := c.instances[typ]; exists {
+	if instance, exists := c.instances[k]; exists {
 		c.mu.RUnlock()
 
-		return instance.(T), nil
+		return instance, nil
 	}
 
 	// Проверяем, есть ли функции
-	function, exists := c.functions[typ]
+	function, exists := c.functions[k]
 	c.mu.RUnlock()
 
 	if !exists {
-		return zero, errors.New("dependency not found: " + typ.String())
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, k)
 	}
 
-	// Если это фабрика (функция), вызываем её
-	if factory, ok := function.(func() T); ok {
-		instance := factory()
-		Register(c, instance)
-		c.mu.Lock()
-		delete(c.functions, typ)
-		c.mu.Unlock()
-
-		return instance, nil
+	// Если это фабрика без аргументов с одним результатом, вызываем её
+	factory := reflect.ValueOf(function)
+	if factory.Type().NumIn() != 0 || factory.Type().NumOut() != 1 {
+		return nil, fmt.Errorf("%w: %s", ErrWrongType, k)
 	}
 
-	return zero, errors.New("wrong dependency type: " + typ.String())
+	instance := factory.Call(nil)[0].Interface()
+	c.mu.Lock()
+	c.instances[k] = instance
+	delete(c.functions, k)
+	c.mu.Unlock()
+
+	return instance, nil
 }
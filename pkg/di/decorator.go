@@ -0,0 +1,60 @@
+package di
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// decorator - обёртка над зависимостью, применяемая при разрешении
+type decorator func(c *Container, instance any) (any, error)
+
+// Interceptor - перехватчик, вызывается при разрешении любой зависимости после декораторов
+// (логирование, метрики и тп). Должен вернуть ту же зависимость или совместимую с typ обёртку.
+type Interceptor func(typ reflect.Type, name string, instance any) (any, error)
+
+// Decorate - добавляет декоратор для зависимости типа T.
+// Декораторы применяются при разрешении зависимости в порядке регистрации.
+func Decorate[T any](c *Container, decorator func(T) T) {
+	DecorateNamedWith(c, "", func(_ *Container, instance T) (T, error) {
+		return decorator(instance), nil
+	})
+}
+
+// DecorateNamed - добавляет декоратор для зависимости, зарегистрированной под именем
+func DecorateNamed[T any](c *Container, name string, decorator func(T) T) {
+	DecorateNamedWith(c, name, func(_ *Container, instance T) (T, error) {
+		return decorator(instance), nil
+	})
+}
+
+// DecorateWith - добавляет декоратор, которому доступен контейнер для разрешения собственных зависимостей
+func DecorateWith[T any](c *Container, decorator func(c *Container, instance T) (T, error)) {
+	DecorateNamedWith(c, "", decorator)
+}
+
+// DecorateNamedWith - DecorateWith для зависимости, зарегистрированной под именем
+func DecorateNamedWith[T any](c *Container, name string, decorator func(c *Container, instance T) (T, error)) {
+	k := key{reflect.TypeOf((*T)(nil)).Elem(), name}
+
+	wrapped := func(c *Container, instance any) (any, error) {
+		typed, ok := instance.(T)
+		if !ok && instance != nil {
+			return nil, fmt.Errorf("%w: %s", ErrWrongType, k)
+		}
+
+		return decorator(c, typed)
+	}
+
+	c.mu.Lock()
+	c.decorators[k] = append(c.decorators[k], wrapped)
+	delete(c.decorated, k)
+	c.mu.Unlock()
+}
+
+// AddInterceptor - добавляет перехватчик для всех зависимостей контейнера
+func AddInterceptor(c *Container, interceptor Interceptor) {
+	c.mu.Lock()
+	c.interceptors = append(c.interceptors, interceptor)
+	c.decorated = make(map[key]interface{})
+	c.mu.Unlock()
+}
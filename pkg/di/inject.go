@@ -0,0 +1,76 @@
+package di
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const injectTag = "inject"
+
+// Inject - заполняет экспортируемые поля структуры, помеченные тегом inject, зависимостями из контейнера.
+//
+//	`inject:""`              - зависимость по типу поля
+//	`inject:"name"`          - именованная зависимость (RegisterNamed)
+//	`inject:"name,optional"` - если зависимость не найдена, поле остаётся без изменений
+func Inject(c *Container, target any) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("inject: target must be a non-nil pointer to struct, got %T", target)
+	}
+
+	v = v.Elem()
+	t := v.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag, ok := field.Tag.Lookup(injectTag)
+		if !ok {
+			continue
+		}
+
+		if !field.IsExported() {
+			return fmt.Errorf("inject %s.%s: field is not exported", t, field.Name)
+		}
+
+		name, optional := parseInjectTag(tag)
+
+		instance, err := c.resolve(field.Type, name)
+		if err != nil {
+			if optional && errors.Is(err, ErrNotFound) {
+				continue
+			}
+
+			return fmt.Errorf("inject %s.%s: %w", t, field.Name, err)
+		}
+
+		if instance == nil {
+			continue
+		}
+
+		value := reflect.ValueOf(instance)
+		if !value.Type().AssignableTo(field.Type) {
+			return fmt.Errorf("inject %s.%s: %w: %T is not assignable to %s", t, field.Name, ErrWrongType, instance, field.Type)
+		}
+
+		v.Field(i).Set(value)
+	}
+
+	return nil
+}
+
+// parseInjectTag - разбирает тег вида "name,optional"
+func parseInjectTag(tag string) (name string, optional bool) {
+	parts := strings.Split(tag, ",")
+	name = strings.TrimSpace(parts[0])
+
+	for _, opt := range parts[1:] {
+		if strings.TrimSpace(opt) == "optional" {
+			optional = true
+		}
+	}
+
+	return name, optional
+}
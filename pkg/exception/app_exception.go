@@ -1,12 +1,180 @@
 package exception
 
+import (
+	"context"
+	"errors"
+)
+
 // AppException Модель данных для описания ошибки
 type AppException struct {
-	Error         error
+	Err           error
 	Context       map[string]any
 	TrackInSentry bool
+	// Category категория ошибки (по умолчанию CategoryInternal)
+	Category Category
+	// Code машиночитаемый код ошибки (user_not_found). По умолчанию совпадает с категорией.
+	Code string
+	// MessageID идентификатор сообщения go-i18n для пользователя, TemplateData - данные шаблона
+	MessageID    string
+	TemplateData map[string]any
+
+	stack    Stack
+	reported bool
 }
 
 func NewAppException(err error, context map[string]any, trackInSentry bool) *AppException {
-	return &AppException{err, context, trackInSentry}
+	return &AppException{Err: err, Context: context, TrackInSentry: trackInSentry, stack: Callers(1)}
+}
+
+// newException конструктор для категорий. Стек сохраняется с точки вызова публичного конструктора.
+func newException(category Category, code string, message string, err error) *AppException {
+	if err == nil && message != "" {
+		err = errors.New(message)
+	}
+
+	return &AppException{
+		Err:           err,
+		TrackInSentry: category.tracked(),
+		Category:      category,
+		Code:          code,
+		stack:         Callers(2),
+	}
+}
+
+// Error Реализация интерфейса error
+func (e *AppException) Error() string {
+	if e.Err == nil {
+		return e.GetCode()
+	}
+
+	return e.Err.Error()
+}
+
+// Unwrap Исходная ошибка (для errors.Is / errors.As)
+func (e *AppException) Unwrap() error {
+	return e.Err
+}
+
+// Is Сравнение для errors.Is: образец без кода совпадает по категории (ErrNotFound), с кодом - по коду
+func (e *AppException) Is(target error) bool {
+	t, ok := target.(*AppException)
+	if !ok || t.Err != nil {
+		return false
+	}
+
+	if t.Code != "" {
+		return e.GetCode() == t.Code
+	}
+
+	return t.Category != "" && e.GetCategory() == t.Category
+}
+
+// GetCategory Категория ошибки, по умолчанию CategoryInternal
+func (e *AppException) GetCategory() Category {
+	if e.Category == "" {
+		return CategoryInternal
+	}
+
+	return e.Category
+}
+
+// GetCode Машиночитаемый код ошибки, по умолчанию - категория
+func (e *AppException) GetCode() string {
+	if e.Code == "" {
+		return string(e.GetCategory())
+	}
+
+	return e.Code
+}
+
+// WithContext Добавляет поле в контекст ошибки
+func (e *AppException) WithContext(key string, value any) *AppException {
+	if e.Context == nil {
+		e.Context = make(map[string]any)
+	}
+
+	e.Context[key] = value
+
+	return e
+}
+
+// WithMessage Сообщение для пользователя: идентификатор go-i18n и данные шаблона (см. Translator)
+func (e *AppException) WithMessage(messageID string, data map[string]any) *AppException {
+	e.MessageID = messageID
+	e.TemplateData = data
+
+	return e
+}
+
+// Track Отправлять ли ошибку в Sentry
+func (e *AppException) Track(track bool) *AppException {
+	e.TrackInSentry = track
+
+	return e
+}
+
+// MarkReported Отмечает, что ошибка уже записана в лог и отправлена в reporter
+func (e *AppException) MarkReported() *AppException {
+	e.reported = true
+
+	return e
+}
+
+// Reported Ошибка уже отправлена в reporter (см. MarkReported)
+func (e *AppException) Reported() bool {
+	return e.reported
+}
+
+// StackTrace Стек вызовов на момент создания ошибки
+func (e *AppException) StackTrace() Stack {
+	return e.stack
+}
+
+// As Первая AppException в цепочке ошибок
+func As(err error) (*AppException, bool) {
+	var ex *AppException
+	if errors.As(err, &ex) {
+		return ex, true
+	}
+
+	return nil, false
+}
+
+// CategoryOf Категория ошибки. Для ошибок без AppException - CategoryInternal
+// (MultiError - CategoryValidation, context.DeadlineExceeded - CategoryTimeout).
+func CategoryOf(err error) Category {
+	if ex, ok := As(err); ok {
+		return ex.GetCategory()
+	}
+
+	if isMultiError(err) {
+		return CategoryValidation
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return CategoryTimeout
+	}
+
+	return CategoryInternal
+}
+
+// CodeOf Машиночитаемый код ошибки. Для ошибок без AppException - код категории (см. CategoryOf),
+// для MultiError - CodeValidationFailed.
+func CodeOf(err error) string {
+	if ex, ok := As(err); ok {
+		return ex.GetCode()
+	}
+
+	if isMultiError(err) {
+		return CodeValidationFailed
+	}
+
+	return string(CategoryOf(err))
+}
+
+// isMultiError в цепочке есть MultiError (ошибки полей без AppException)
+func isMultiError(err error) bool {
+	var multi *MultiError
+
+	return errors.As(err, &multi)
 }
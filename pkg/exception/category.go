@@ -0,0 +1,84 @@
+package exception
+
+// Category Категория ошибки. Значение стабильное и используется как машиночитаемый код по умолчанию.
+type Category string
+
+const (
+	CategoryNotFound     Category = "not_found"
+	CategoryValidation   Category = "validation"
+	CategoryConflict     Category = "conflict"
+	CategoryUnauthorized Category = "unauthorized"
+	CategoryForbidden    Category = "forbidden"
+	CategoryInternal     Category = "internal"
+	CategoryUnavailable  Category = "unavailable"
+	CategoryTimeout      Category = "timeout"
+)
+
+// Ошибки-образцы категорий для errors.Is:
+//
+//	if errors.Is(err, exception.ErrNotFound) { ... }
+var (
+	ErrNotFound     = &AppException{Category: CategoryNotFound}
+	ErrValidation   = &AppException{Category: CategoryValidation}
+	ErrConflict     = &AppException{Category: CategoryConflict}
+	ErrUnauthorized = &AppException{Category: CategoryUnauthorized}
+	ErrForbidden    = &AppException{Category: CategoryForbidden}
+	ErrInternal     = &AppException{Category: CategoryInternal}
+	ErrUnavailable  = &AppException{Category: CategoryUnavailable}
+	ErrTimeout      = &AppException{Category: CategoryTimeout}
+)
+
+// tracked Категории, которые по умолчанию отправляются в Sentry (ошибки сервиса, а не клиента)
+func (c Category) tracked() bool {
+	switch c {
+	case CategoryInternal, CategoryUnavailable, CategoryTimeout:
+		return true
+	}
+
+	return false
+}
+
+// NotFound Ресурс не найден
+func NotFound(code string, message string) *AppException {
+	return newException(CategoryNotFound, code, message, nil)
+}
+
+// Validation Некорректные входные данные
+func Validation(code string, message string) *AppException {
+	return newException(CategoryValidation, code, message, nil)
+}
+
+// Conflict Конфликт с текущим состоянием (дубликат, устаревшая версия)
+func Conflict(code string, message string) *AppException {
+	return newException(CategoryConflict, code, message, nil)
+}
+
+// Unauthorized Не пройдена аутентификация
+func Unauthorized(code string, message string) *AppException {
+	return newException(CategoryUnauthorized, code, message, nil)
+}
+
+// Forbidden Нет прав на операцию
+func Forbidden(code string, message string) *AppException {
+	return newException(CategoryForbidden, code, message, nil)
+}
+
+// Internal Внутренняя ошибка. Исходная ошибка err сохраняется для errors.Is / errors.As.
+func Internal(code string, err error) *AppException {
+	return newException(CategoryInternal, code, "", err)
+}
+
+// Unavailable Зависимость (БД, внешний сервис) недоступна
+func Unavailable(code string, err error) *AppException {
+	return newException(CategoryUnavailable, code, "", err)
+}
+
+// Timeout Превышено время ожидания
+func Timeout(code string, err error) *AppException {
+	return newException(CategoryTimeout, code, "", err)
+}
+
+// Wrap Оборачивает ошибку в AppException указанной категории
+func Wrap(err error, category Category, code string) *AppException {
+	return newException(category, code, "", err)
+}
@@ -0,0 +1,60 @@
+package exception
+
+import (
+	"github.com/exgamer/gosdk-core/pkg/constants"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// NewBundle Bundle go-i18n с русским языком по умолчанию. Файлы сообщений json: bundle.MustLoadMessageFile("ru.json").
+func NewBundle() *i18n.Bundle {
+	return i18n.NewBundle(language.Russian)
+}
+
+// Translator Перевод сообщений ошибок на язык запроса
+type Translator struct {
+	bundle *i18n.Bundle
+}
+
+func NewTranslator(bundle *i18n.Bundle) *Translator {
+	return &Translator{bundle: bundle}
+}
+
+// Message Сообщение ошибки на языке langCode (ru, kk, en; неизвестный код - ru).
+// Ищется MessageID ошибки, затем её код и категория; если перевода нет ни на одном языке - текст как в NewErrorResponse.
+func (t *Translator) Message(err error, langCode string) string {
+	lang := constants.GetLanguageCode(constants.GetLanguageByCode(langCode))
+	localizer := i18n.NewLocalizer(t.bundle, lang, constants.LangCodeRu)
+
+	var data map[string]any
+
+	ids := make([]string, 0, 3)
+
+	if ex, ok := As(err); ok {
+		data = ex.TemplateData
+		if ex.MessageID != "" {
+			ids = append(ids, ex.MessageID)
+		}
+
+		ids = append(ids, ex.GetCode())
+	}
+
+	ids = append(ids, string(CategoryOf(err)))
+
+	for _, id := range ids {
+		message, lerr := localizer.Localize(&i18n.LocalizeConfig{MessageID: id, TemplateData: data})
+		if lerr == nil {
+			return message
+		}
+	}
+
+	return NewErrorResponse(err).Message
+}
+
+// ErrorResponse NewErrorResponse с сообщением на языке langCode
+func (t *Translator) ErrorResponse(err error, langCode string) *ErrorResponse {
+	response := NewErrorResponse(err)
+	response.Message = t.Message(err, langCode)
+
+	return response
+}
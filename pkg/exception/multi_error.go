@@ -0,0 +1,166 @@
+package exception
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CodeValidationFailed Код ошибки с набором ошибок полей (MultiError.Err)
+const CodeValidationFailed = "validation_failed"
+
+// validationFailedMessage Сообщение ответа с ошибками полей (сами ошибки - в details)
+const validationFailedMessage = "validation failed"
+
+// PathError Ошибка поля или элемента: name, items[3].price
+type PathError struct {
+	Path string
+	Err  error
+}
+
+func (e PathError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e PathError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError Набор ошибок полей и элементов (валидация, пакетные операции)
+//
+//	errs := exception.NewMultiError()
+//	for i, item := range req.Items {
+//	    if item.Price <= 0 {
+//	        errs.Add(exception.Index("items", i)+".price", errors.New("must be positive"))
+//	    }
+//	}
+//	return errs.Err()
+type MultiError struct {
+	errors []PathError
+}
+
+func NewMultiError() *MultiError {
+	return &MultiError{}
+}
+
+// NewMultiErrorFromMap Ошибки из map поле -> сообщение (результат validation.BindANdValidateStruct)
+func NewMultiErrorFromMap(fields map[string]string) *MultiError {
+	m := NewMultiError()
+	m.AddMap("", fields)
+
+	return m
+}
+
+// Add Добавляет ошибку по пути. Вложенный MultiError раскладывается с префиксом path.
+func (m *MultiError) Add(path string, err error) {
+	if err == nil {
+		return
+	}
+
+	var nested *MultiError
+	if errors.As(err, &nested) && nested != m {
+		for _, e := range nested.errors {
+			m.errors = append(m.errors, PathError{Path: JoinPath(path, e.Path), Err: e.Err})
+		}
+
+		return
+	}
+
+	m.errors = append(m.errors, PathError{Path: path, Err: err})
+}
+
+// AddMessage Добавляет ошибку с текстом message
+func (m *MultiError) AddMessage(path string, message string) {
+	m.Add(path, errors.New(message))
+}
+
+// AddMap Добавляет ошибки из map поле -> сообщение (в порядке сортировки полей), prefix - путь родителя
+func (m *MultiError) AddMap(prefix string, fields map[string]string) {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		m.AddMessage(JoinPath(prefix, k), fields[k])
+	}
+}
+
+// Len Количество ошибок
+func (m *MultiError) Len() int {
+	return len(m.errors)
+}
+
+// Errors Ошибки с путями
+func (m *MultiError) Errors() []PathError {
+	return append([]PathError(nil), m.errors...)
+}
+
+// Err nil, если ошибок нет, иначе AppException категории validation с кодом validation_failed
+func (m *MultiError) Err() error {
+	if m.Len() == 0 {
+		return nil
+	}
+
+	return newException(CategoryValidation, CodeValidationFailed, "", m)
+}
+
+func (m *MultiError) Error() string {
+	parts := make([]string, len(m.errors))
+	for i, e := range m.errors {
+		parts[i] = e.Error()
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+// Unwrap Ошибки для errors.Is / errors.As
+func (m *MultiError) Unwrap() []error {
+	result := make([]error, len(m.errors))
+	for i, e := range m.errors {
+		result[i] = e.Err
+	}
+
+	return result
+}
+
+// Details Ошибки в формате ErrorResponse
+func (m *MultiError) Details() []ErrorDetail {
+	result := make([]ErrorDetail, len(m.errors))
+
+	for i, e := range m.errors {
+		result[i] = ErrorDetail{Path: e.Path, Message: e.Err.Error()}
+		if ex, ok := As(e.Err); ok {
+			result[i].Code = ex.GetCode()
+		}
+	}
+
+	return result
+}
+
+// Index Путь элемента списка: Index("items", 3) -> items[3]
+func Index(path string, i int) string {
+	return path + "[" + strconv.Itoa(i) + "]"
+}
+
+// JoinPath Объединяет путь родителя и поля: items[3] + price -> items[3].price, items + [3] -> items[3]
+func JoinPath(prefix string, path string) string {
+	switch {
+	case prefix == "":
+		return path
+	case path == "":
+		return prefix
+	case strings.HasPrefix(path, "["):
+		return prefix + path
+	default:
+		return fmt.Sprintf("%s.%s", prefix, path)
+	}
+}
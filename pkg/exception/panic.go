@@ -0,0 +1,26 @@
+package exception
+
+import (
+	"fmt"
+)
+
+// CodePanic Код ошибки, в которую превращается перехваченная паника
+const CodePanic = "panic"
+
+// FromPanic Превращает значение recover() в AppException (internal, TrackInSentry) со стеком места паники.
+// Вызывать нужно прямо в defer-функции, где вызван recover().
+func FromPanic(recovered any) *AppException {
+	err, ok := recovered.(error)
+	if !ok {
+		err = fmt.Errorf("%v", recovered)
+	}
+
+	return &AppException{
+		Err:           fmt.Errorf("panic: %w", err),
+		TrackInSentry: true,
+		Category:      CategoryInternal,
+		Code:          CodePanic,
+		// пропускаем defer-функцию и runtime.gopanic: стек начинается с места паники
+		stack: Callers(3),
+	}
+}
@@ -0,0 +1,106 @@
+package exception
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"github.com/exgamer/gosdk-core/pkg/constants"
+	"net/http"
+)
+
+// ErrorResponse Стандартное тело ответа с ошибкой
+type ErrorResponse struct {
+	XMLName   xml.Name      `json:"-" xml:"error"`
+	Code      string        `json:"code" xml:"code"`
+	Message   string        `json:"message" xml:"message"`
+	Details   []ErrorDetail `json:"details,omitempty" xml:"details>detail,omitempty"`
+	RequestID string        `json:"request_id,omitempty" xml:"request_id,omitempty"`
+	TraceID   string        `json:"trace_id,omitempty" xml:"trace_id,omitempty"`
+
+	status int
+}
+
+// ErrorDetail Ошибка отдельного поля или элемента (items[3].price)
+type ErrorDetail struct {
+	Path    string `json:"path,omitempty" xml:"path,attr,omitempty"`
+	Code    string `json:"code,omitempty" xml:"code,attr,omitempty"`
+	Message string `json:"message" xml:",chardata"`
+}
+
+// NewErrorResponse Ответ по ошибке: код и HTTP статус по категории, ошибки полей MultiError - в details.
+// Для внутренних ошибок (internal, unavailable, timeout) текст ошибки не раскрывается - в ответе стандартный текст статуса.
+func NewErrorResponse(err error) *ErrorResponse {
+	category := CategoryOf(err)
+	status := category.HTTPStatus()
+
+	message := http.StatusText(status)
+	if err != nil && !category.tracked() {
+		message = err.Error()
+	}
+
+	response := &ErrorResponse{
+		Code:    CodeOf(err),
+		Message: message,
+		status:  status,
+	}
+
+	// ошибки полей - в details, в message только общий текст
+	var multi *MultiError
+	if errors.As(err, &multi) {
+		response.Details = multi.Details()
+		if !category.tracked() {
+			response.Message = validationFailedMessage
+		}
+	}
+
+	return response
+}
+
+// WithRequestID Идентификатор запроса (например, logger.RequestIDFromContext(ctx))
+func (r *ErrorResponse) WithRequestID(requestID string) *ErrorResponse {
+	r.RequestID = requestID
+
+	return r
+}
+
+// WithTraceID Идентификатор трассировки
+func (r *ErrorResponse) WithTraceID(traceID string) *ErrorResponse {
+	r.TraceID = traceID
+
+	return r
+}
+
+// WithDetails Добавляет ошибки отдельных полей
+func (r *ErrorResponse) WithDetails(details ...ErrorDetail) *ErrorResponse {
+	r.Details = append(r.Details, details...)
+
+	return r
+}
+
+// Status HTTP статус ответа
+func (r *ErrorResponse) Status() int {
+	if r.status == 0 {
+		return http.StatusInternalServerError
+	}
+
+	return r.status
+}
+
+// Render Пишет ответ в формате constants.JSON или constants.XML (по умолчанию JSON)
+func (r *ErrorResponse) Render(w http.ResponseWriter, format string) error {
+	if format == constants.XML {
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		w.WriteHeader(r.Status())
+
+		if _, err := w.Write([]byte(xml.Header)); err != nil {
+			return err
+		}
+
+		return xml.NewEncoder(w).Encode(r)
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(r.Status())
+
+	return json.NewEncoder(w).Encode(r)
+}
@@ -0,0 +1,46 @@
+package exception
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// maxStackDepth Максимальная глубина сохраняемого стека
+const maxStackDepth = 32
+
+// Stack Стек вызовов, сохранённый в момент создания ошибки
+type Stack []uintptr
+
+// Callers Сохраняет текущий стек вызовов, skip - сколько кадров пропустить (0 - вызывающая функция)
+func Callers(skip int) Stack {
+	pcs := make([]uintptr, maxStackDepth)
+	n := runtime.Callers(skip+2, pcs)
+
+	return pcs[:n]
+}
+
+// Frames Кадры стека в формате "функция file:line"
+func (s Stack) Frames() []string {
+	if len(s) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0, len(s))
+	frames := runtime.CallersFrames(s)
+
+	for {
+		frame, more := frames.Next()
+		result = append(result, frame.Function+" "+frame.File+":"+strconv.Itoa(frame.Line))
+
+		if !more {
+			break
+		}
+	}
+
+	return result
+}
+
+func (s Stack) String() string {
+	return strings.Join(s.Frames(), "\n")
+}
@@ -0,0 +1,90 @@
+package exception
+
+import (
+	"net/http"
+)
+
+// GRPCCode Код статуса gRPC. Значения совпадают с google.golang.org/grpc/codes, поэтому приводятся как codes.Code(c).
+type GRPCCode uint32
+
+const (
+	GRPCCodeOK                 GRPCCode = 0
+	GRPCCodeCanceled           GRPCCode = 1
+	GRPCCodeUnknown            GRPCCode = 2
+	GRPCCodeInvalidArgument    GRPCCode = 3
+	GRPCCodeDeadlineExceeded   GRPCCode = 4
+	GRPCCodeNotFound           GRPCCode = 5
+	GRPCCodeAlreadyExists      GRPCCode = 6
+	GRPCCodePermissionDenied   GRPCCode = 7
+	GRPCCodeResourceExhausted  GRPCCode = 8
+	GRPCCodeFailedPrecondition GRPCCode = 9
+	GRPCCodeAborted            GRPCCode = 10
+	GRPCCodeOutOfRange         GRPCCode = 11
+	GRPCCodeUnimplemented      GRPCCode = 12
+	GRPCCodeInternal           GRPCCode = 13
+	GRPCCodeUnavailable        GRPCCode = 14
+	GRPCCodeDataLoss           GRPCCode = 15
+	GRPCCodeUnauthenticated    GRPCCode = 16
+)
+
+// HTTPStatus HTTP статус категории
+func (c Category) HTTPStatus() int {
+	switch c {
+	case CategoryNotFound:
+		return http.StatusNotFound
+	case CategoryValidation:
+		return http.StatusUnprocessableEntity
+	case CategoryConflict:
+		return http.StatusConflict
+	case CategoryUnauthorized:
+		return http.StatusUnauthorized
+	case CategoryForbidden:
+		return http.StatusForbidden
+	case CategoryUnavailable:
+		return http.StatusServiceUnavailable
+	case CategoryTimeout:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// GRPCCode Код статуса gRPC категории
+func (c Category) GRPCCode() GRPCCode {
+	switch c {
+	case CategoryNotFound:
+		return GRPCCodeNotFound
+	case CategoryValidation:
+		return GRPCCodeInvalidArgument
+	case CategoryConflict:
+		return GRPCCodeAlreadyExists
+	case CategoryUnauthorized:
+		return GRPCCodeUnauthenticated
+	case CategoryForbidden:
+		return GRPCCodePermissionDenied
+	case CategoryUnavailable:
+		return GRPCCodeUnavailable
+	case CategoryTimeout:
+		return GRPCCodeDeadlineExceeded
+	default:
+		return GRPCCodeInternal
+	}
+}
+
+// HTTPStatus HTTP статус ошибки по её категории (nil - 200)
+func HTTPStatus(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+
+	return CategoryOf(err).HTTPStatus()
+}
+
+// GRPCStatus Код статуса gRPC ошибки по её категории (nil - OK)
+func GRPCStatus(err error) GRPCCode {
+	if err == nil {
+		return GRPCCodeOK
+	}
+
+	return CategoryOf(err).GRPCCode()
+}
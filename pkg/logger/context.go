@@ -0,0 +1,120 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"github.com/exgamer/gosdk-core/pkg/helpers"
+	"log/slog"
+)
+
+// Имена стандартных полей запроса
+const (
+	RequestIDKey = "request_id"
+	TraceIDKey   = "trace_id"
+	UserIDKey    = "user_id"
+	ServiceKey   = "service"
+	EnvKey       = "env"
+)
+
+// безопасные ключи (без коллизий со строками)
+type fieldsCtxKey struct{}
+type loggerCtxKey struct{}
+
+// WithLogger кладёт логгер в context (например, логгер модуля). FromContext вернёт его вместо Default().
+func WithLogger(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerCtxKey{}, l)
+}
+
+// With добавляет в context поля, которые попадут во все логи через FromContext ниже по стеку вызовов.
+// Аргументы как у slog: "key", value, ... или slog.Attr.
+func With(ctx context.Context, args ...any) context.Context {
+	prev, _ := ctx.Value(fieldsCtxKey{}).([]any)
+
+	fields := make([]any, 0, len(prev)+len(args))
+	fields = append(fields, prev...)
+	fields = append(fields, args...)
+
+	return context.WithValue(ctx, fieldsCtxKey{}, fields)
+}
+
+// WithRequestID добавляет в context идентификатор запроса
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return With(ctx, RequestIDKey, requestID)
+}
+
+// WithTraceID добавляет в context идентификатор трассировки
+func WithTraceID(ctx context.Context, traceID string) context.Context {
+	return With(ctx, TraceIDKey, traceID)
+}
+
+// WithUserID добавляет в context идентификатор пользователя
+func WithUserID(ctx context.Context, userID any) context.Context {
+	return With(ctx, UserIDKey, userID)
+}
+
+// FromContext возвращает логгер с полями из context: AppInfo (service, env) и поля, добавленные через With
+func FromContext(ctx context.Context) *slog.Logger {
+	if ctx == nil {
+		return Default()
+	}
+
+	l, ok := ctx.Value(loggerCtxKey{}).(*slog.Logger)
+	if !ok || l == nil {
+		l = Default()
+	}
+
+	fields := make([]any, 0, 4)
+
+	if info := helpers.GetAppInfoFromContext(ctx); info != nil {
+		fields = append(fields, ServiceKey, info.ServiceName, EnvKey, info.AppEnv)
+	}
+
+	if ctxFields, ok := ctx.Value(fieldsCtxKey{}).([]any); ok {
+		fields = append(fields, ctxFields...)
+	}
+
+	if len(fields) == 0 {
+		return l
+	}
+
+	return l.With(fields...)
+}
+
+// RequestIDFromContext Идентификатор запроса, добавленный через WithRequestID
+func RequestIDFromContext(ctx context.Context) string {
+	return fieldFromContext(ctx, RequestIDKey)
+}
+
+// TraceIDFromContext Идентификатор трассировки, добавленный через WithTraceID
+func TraceIDFromContext(ctx context.Context) string {
+	return fieldFromContext(ctx, TraceIDKey)
+}
+
+// fieldFromContext строковое значение поля из With (последнее добавленное)
+func fieldFromContext(ctx context.Context, key string) string {
+	if ctx == nil {
+		return ""
+	}
+
+	fields, _ := ctx.Value(fieldsCtxKey{}).([]any)
+	result := ""
+
+	for i := 0; i < len(fields); i++ {
+		switch f := fields[i].(type) {
+		case slog.Attr:
+			if f.Key == key {
+				result = f.Value.String()
+			}
+		case string:
+			if i+1 < len(fields) {
+				if f == key {
+					result = fmt.Sprint(fields[i+1])
+				}
+
+				i++
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,73 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"github.com/exgamer/gosdk-core/pkg/exception"
+	"github.com/exgamer/gosdk-core/pkg/reporter"
+	"log/slog"
+)
+
+// ErrorKey Поле с описанием ошибки
+const ErrorKey = "error"
+
+// Err Поле лога с ошибкой: сообщение, контекст AppException и стек вызовов.
+// Для AppException (в том числе обёрнутой) используется стек момента создания ошибки.
+//
+//	l.Error("payment failed", logger.Err(err))
+func Err(err error) slog.Attr {
+	return errorAttr(err, exception.Callers(1))
+}
+
+func errorAttr(err error, stack exception.Stack) slog.Attr {
+	if err == nil {
+		return slog.Attr{}
+	}
+
+	attrs := []any{slog.String("message", err.Error())}
+
+	var ex *exception.AppException
+	if errors.As(err, &ex) {
+		attrs = append(attrs, slog.String("code", ex.GetCode()), slog.String("category", string(ex.GetCategory())))
+
+		if len(ex.Context) > 0 {
+			fields := make([]any, 0, len(ex.Context))
+			for k, v := range ex.Context {
+				fields = append(fields, slog.Any(k, v))
+			}
+
+			attrs = append(attrs, slog.Group("context", fields...))
+		}
+
+		if len(ex.StackTrace()) > 0 {
+			stack = ex.StackTrace()
+		}
+	}
+
+	attrs = append(attrs, slog.Any("stack", stack.Frames()))
+
+	return slog.Group(ErrorKey, attrs...)
+}
+
+// LogError лог с ошибкой. Контекст AppException пишется полями, ошибки с TrackInSentry отправляются в reporter.
+func LogError(err error) {
+	logErrorContext(context.Background(), err, exception.Callers(1))
+}
+
+// LogErrorContext LogError с полями запроса из context (см. FromContext)
+func LogErrorContext(ctx context.Context, err error) {
+	logErrorContext(ctx, err, exception.Callers(1))
+}
+
+func logErrorContext(ctx context.Context, err error, stack exception.Stack) {
+	if err == nil {
+		return
+	}
+
+	FromContext(ctx).LogAttrs(ctx, slog.LevelError, err.Error(), errorAttr(err, stack))
+
+	var ex *exception.AppException
+	if errors.As(err, &ex) && ex.TrackInSentry {
+		reporter.Default().Capture(ctx, err)
+	}
+}
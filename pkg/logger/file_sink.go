@@ -0,0 +1,197 @@
+package logger
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// backupTimeFormat Суффикс ротированных файлов: app.log.2024-01-31T23-59-59.000000
+const backupTimeFormat = "2006-01-02T15-04-05.000000"
+
+// FileOptions Настройки файлового приёмника с ротацией
+type FileOptions struct {
+	// Path путь к файлу лога
+	Path string
+	// MaxSize ротация при превышении размера в байтах, 0 - без ограничения
+	MaxSize int64
+	// RotateEvery ротация по времени (например, 24h), 0 - без ротации по времени
+	RotateEvery time.Duration
+	// MaxBackups сколько ротированных файлов хранить, 0 - без ограничения
+	MaxBackups int
+	// MaxAge сколько хранить ротированные файлы, 0 - без ограничения
+	MaxAge time.Duration
+}
+
+// NewFileSink Файловый приёмник с ротацией по размеру и времени и удалением старых файлов
+func NewFileSink(opts FileOptions) (*FileSink, error) {
+	if opts.Path == "" {
+		return nil, errors.New("log file path is empty")
+	}
+
+	s := &FileSink{opts: opts}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// FileSink Файловый приёмник с ротацией
+type FileSink struct {
+	mu       sync.Mutex
+	opts     FileOptions
+	file     *os.File
+	size     int64
+	openedAt time.Time
+}
+
+func (s *FileSink) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	if s.shouldRotate(int64(len(p))) {
+		if err := s.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := s.file.Write(p)
+	s.size += int64(n)
+
+	return n, err
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+
+	err := s.file.Close()
+	s.file = nil
+
+	return err
+}
+
+// Rotate Принудительная ротация (например, по сигналу от logrotate)
+func (s *FileSink) Rotate() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.rotate()
+}
+
+func (s *FileSink) shouldRotate(next int64) bool {
+	if s.size == 0 {
+		return false
+	}
+
+	if s.opts.MaxSize > 0 && s.size+next > s.opts.MaxSize {
+		return true
+	}
+
+	return s.opts.RotateEvery > 0 && time.Since(s.openedAt) >= s.opts.RotateEvery
+}
+
+func (s *FileSink) open() error {
+	if err := os.MkdirAll(filepath.Dir(s.opts.Path), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(s.opts.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	s.file = file
+	s.size = info.Size()
+	s.openedAt = time.Now()
+
+	// продолжаем существующий файл: время ротации отсчитываем от последней записи в него
+	if s.size > 0 {
+		s.openedAt = info.ModTime()
+	}
+
+	return nil
+}
+
+func (s *FileSink) rotate() error {
+	if s.file != nil {
+		if err := s.file.Close(); err != nil {
+			return err
+		}
+
+		s.file = nil
+	}
+
+	backup := s.opts.Path + "." + time.Now().Format(backupTimeFormat)
+	if err := os.Rename(s.opts.Path, backup); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if err := s.open(); err != nil {
+		return err
+	}
+
+	s.cleanup()
+
+	return nil
+}
+
+// cleanup Удаляет ротированные файлы сверх MaxBackups и старше MaxAge
+func (s *FileSink) cleanup() {
+	if s.opts.MaxBackups <= 0 && s.opts.MaxAge <= 0 {
+		return
+	}
+
+	matches, err := filepath.Glob(s.opts.Path + ".*")
+	if err != nil {
+		return
+	}
+
+	backups := make([]string, 0, len(matches))
+	for _, m := range matches {
+		if _, err := time.Parse(backupTimeFormat, strings.TrimPrefix(m, s.opts.Path+".")); err == nil {
+			backups = append(backups, m)
+		}
+	}
+
+	// от новых к старым (суффикс - время, сортируется как строка)
+	sort.Sort(sort.Reverse(sort.StringSlice(backups)))
+
+	for i, backup := range backups {
+		expired := false
+
+		if s.opts.MaxBackups > 0 && i >= s.opts.MaxBackups {
+			expired = true
+		}
+
+		if s.opts.MaxAge > 0 {
+			if info, err := os.Stat(backup); err == nil && time.Since(info.ModTime()) > s.opts.MaxAge {
+				expired = true
+			}
+		}
+
+		if expired {
+			_ = os.Remove(backup)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package logger
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+)
+
+// LevelsHandler Admin-endpoint для управления уровнями логгеров на лету:
+//
+//	GET                              - текущие уровни ("" - общий уровень)
+//	PUT/POST ?logger=payments&level=debug - установить уровень (без logger - общий уровень)
+//	DELETE   ?logger=payments        - сбросить уровень логгера на общий
+//
+// Endpoint нужно закрывать авторизацией и не публиковать наружу.
+func LevelsHandler(r *Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		name := req.URL.Query().Get("logger")
+
+		switch req.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			var level slog.Level
+			if err := level.UnmarshalText([]byte(req.URL.Query().Get("level"))); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+
+				return
+			}
+
+			r.SetLevel(name, level)
+			Default().Info("log level changed", NameKey, name, "level", level.String())
+		case http.MethodDelete:
+			r.ResetLevel(name)
+			Default().Info("log level reset", NameKey, name)
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST, DELETE")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(r.Levels())
+	})
+}
@@ -0,0 +1,155 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Config Настройки логгера
+type Config struct {
+	Level     slog.Level `mapstructure:"LOG_LEVEL" default:"info" desc:"Уровень логирования: debug, info, warn, error"`
+	Format    string     `mapstructure:"LOG_FORMAT" default:"text" validate:"oneof=text json" desc:"Формат логов: text, json"`
+	AddSource bool       `mapstructure:"LOG_ADD_SOURCE" default:"false" desc:"Добавлять в лог файл и строку вызова"`
+	// Levels уровни отдельных логгеров (модулей): LOG_LEVELS=payments=debug,http=warn или LOG_LEVELS_PAYMENTS=debug
+	Levels map[string]slog.Level `mapstructure:"LOG_LEVELS" desc:"Уровни логгеров модулей: payments=debug,http=warn"`
+
+	Output      string         `mapstructure:"LOG_OUTPUT" default:"stdout" desc:"Куда писать логи через запятую: stdout, stderr, file, memory"`
+	File        FileConfig     `mapstructure:"LOG_FILE"`
+	MemorySize  int            `mapstructure:"LOG_MEMORY_SIZE" default:"1000" validate:"gte=1" desc:"Сколько последних записей хранить в памяти (output memory)"`
+	Async       bool           `mapstructure:"LOG_ASYNC" default:"false" desc:"Асинхронная буферизированная запись"`
+	AsyncBuffer int            `mapstructure:"LOG_ASYNC_BUFFER" default:"1024" validate:"gte=1" desc:"Размер очереди асинхронной записи"`
+	Sampling    SamplingConfig `mapstructure:"LOG_SAMPLING"`
+}
+
+// FileConfig Настройки записи в файл (LOG_FILE_*)
+type FileConfig struct {
+	Path        string        `mapstructure:"PATH" desc:"Путь к файлу лога"`
+	MaxSizeMB   int           `mapstructure:"MAX_SIZE_MB" default:"100" validate:"gte=0" desc:"Ротация при превышении размера в МБ, 0 - без ограничения"`
+	RotateEvery time.Duration `mapstructure:"ROTATE_EVERY" default:"0s" desc:"Ротация по времени, например 24h, 0 - выключено"`
+	MaxBackups  int           `mapstructure:"MAX_BACKUPS" default:"7" validate:"gte=0" desc:"Сколько ротированных файлов хранить, 0 - без ограничения"`
+	MaxAge      time.Duration `mapstructure:"MAX_AGE" default:"0s" desc:"Сколько хранить ротированные файлы, 0 - без ограничения"`
+}
+
+// SamplingConfig Настройки сэмплирования (LOG_SAMPLING_*)
+type SamplingConfig struct {
+	Interval   time.Duration `mapstructure:"INTERVAL" default:"0s" desc:"Интервал сэмплирования, 0 - выключено"`
+	First      int           `mapstructure:"FIRST" default:"100" desc:"Сколько одинаковых записей за интервал писать полностью"`
+	Thereafter int           `mapstructure:"THEREAFTER" default:"100" desc:"Затем писать каждую N-ю запись"`
+}
+
+var (
+	// defaultLogger Логгер по умолчанию, используется хелперами пакета
+	defaultLogger atomic.Pointer[slog.Logger]
+	// defaultRegistry Реестр именованных логгеров по умолчанию
+	defaultRegistry atomic.Pointer[Registry]
+)
+
+func init() {
+	registry := NewRegistry(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: levelAll}), slog.LevelInfo)
+	defaultRegistry.Store(registry)
+	defaultLogger.Store(registry.Logger())
+}
+
+// New Создаёт структурированный логгер (log/slog), который пишет в stdout
+func New(cfg Config) (*slog.Logger, error) {
+	return NewWithWriter(cfg, os.Stdout)
+}
+
+// NewWithWriter Создаёт структурированный логгер, который пишет в w
+func NewWithWriter(cfg Config, w io.Writer) (*slog.Logger, error) {
+	registry, err := NewRegistryFromConfig(cfg, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return registry.Logger(), nil
+}
+
+// NewRegistryFromConfig Создаёт реестр именованных логгеров по настройкам, логи пишутся в w
+func NewRegistryFromConfig(cfg Config, w io.Writer) (*Registry, error) {
+	handler, err := NewHandler(cfg, w)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Sampling.Interval > 0 {
+		handler = NewSamplingHandler(handler, SamplingOptions{
+			Interval:   cfg.Sampling.Interval,
+			First:      cfg.Sampling.First,
+			Thereafter: cfg.Sampling.Thereafter,
+		})
+	}
+
+	registry := NewRegistry(handler, cfg.Level)
+	registry.SetLevels(cfg.Levels)
+
+	return registry, nil
+}
+
+// NewHandler Создаёт обработчик text/json, который пропускает все уровни (фильтрацию делает Registry)
+func NewHandler(cfg Config, w io.Writer) (slog.Handler, error) {
+	opts := &slog.HandlerOptions{Level: levelAll, AddSource: cfg.AddSource}
+
+	switch strings.ToLower(cfg.Format) {
+	case FormatJSON:
+		return slog.NewJSONHandler(w, opts), nil
+	case FormatText, "":
+		return slog.NewTextHandler(w, opts), nil
+	default:
+		return nil, fmt.Errorf("unknown log format %q, expected text or json", cfg.Format)
+	}
+}
+
+// ParseLevel Разбирает уровень логирования: debug, info, warn (warning), error. Пустая строка - info.
+func ParseLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info", "":
+		return slog.LevelInfo, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return slog.LevelInfo, fmt.Errorf("unknown log level %q, expected debug, info, warn or error", level)
+	}
+}
+
+// Default Логгер по умолчанию
+func Default() *slog.Logger {
+	return defaultLogger.Load()
+}
+
+// Named Именованный логгер (модуля) из реестра по умолчанию
+func Named(name string) *slog.Logger {
+	return DefaultRegistry().Named(name)
+}
+
+// DefaultRegistry Реестр именованных логгеров по умолчанию
+func DefaultRegistry() *Registry {
+	return defaultRegistry.Load()
+}
+
+// SetDefaultRegistry Устанавливает реестр по умолчанию и его корневой логгер как логгер по умолчанию
+func SetDefaultRegistry(r *Registry) {
+	defaultRegistry.Store(r)
+	SetDefault(r.Logger())
+}
+
+// SetDefault Устанавливает логгер по умолчанию для пакета logger и log/slog
+// (вывод стандартного пакета log тоже пойдёт через него)
+func SetDefault(l *slog.Logger) {
+	defaultLogger.Store(l)
+	slog.SetDefault(l)
+}
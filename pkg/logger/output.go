@@ -0,0 +1,110 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// Output Приёмники логов, собранные по настройкам
+type Output struct {
+	// Sink итоговый приёмник (с учётом LOG_ASYNC)
+	Sink Sink
+	// Memory кольцевой буфер последних записей, если LOG_OUTPUT содержит memory
+	Memory *RingBuffer
+
+	async  *AsyncSink
+	closed atomic.Bool
+}
+
+// NewOutput Собирает приёмники по настройкам LOG_OUTPUT, LOG_FILE_*, LOG_ASYNC
+func NewOutput(cfg Config) (*Output, error) {
+	out := &Output{}
+	sinks := make([]Sink, 0, 2)
+
+	for _, name := range strings.Split(cfg.Output, ",") {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "stdout", "":
+			sinks = append(sinks, NewStdoutSink())
+		case "stderr":
+			sinks = append(sinks, NewStderrSink())
+		case "file":
+			file, err := NewFileSink(FileOptions{
+				Path:        cfg.File.Path,
+				MaxSize:     int64(cfg.File.MaxSizeMB) * 1024 * 1024,
+				RotateEvery: cfg.File.RotateEvery,
+				MaxBackups:  cfg.File.MaxBackups,
+				MaxAge:      cfg.File.MaxAge,
+			})
+			if err != nil {
+				return nil, errors.Join(fmt.Errorf("log output file: %w", err), NewMultiSink(sinks...).Close())
+			}
+
+			sinks = append(sinks, file)
+		case "memory":
+			out.Memory = NewRingBuffer(cfg.MemorySize)
+			sinks = append(sinks, out.Memory)
+		default:
+			return nil, errors.Join(fmt.Errorf("unknown log output %q, expected stdout, stderr, file or memory", name), NewMultiSink(sinks...).Close())
+		}
+	}
+
+	sink := NewMultiSink(sinks...)
+	if len(sinks) == 1 {
+		sink = sinks[0]
+	}
+
+	if cfg.Async {
+		out.async = NewAsyncSink(sink, cfg.AsyncBuffer)
+		sink = out.async
+	}
+
+	out.Sink = &fallbackSink{sink: sink, output: out}
+
+	return out, nil
+}
+
+// Flush Дописывает буферизированные записи (stop hook App)
+func (o *Output) Flush(ctx context.Context) error {
+	if o.async != nil {
+		return o.async.Flush(ctx)
+	}
+
+	return nil
+}
+
+// Close Дописывает буферизированные записи и закрывает приёмники.
+// Записи после Close не теряются: они пишутся в stderr.
+func (o *Output) Close() error {
+	if o.closed.Swap(true) {
+		return nil
+	}
+
+	return o.Sink.(*fallbackSink).sink.Close()
+}
+
+// fallbackSink пишет в stderr записи, которые пришли после закрытия приёмников (логи последних stop hook)
+type fallbackSink struct {
+	sink   Sink
+	output *Output
+}
+
+func (f *fallbackSink) Write(p []byte) (int, error) {
+	if f.output.closed.Load() {
+		return os.Stderr.Write(p)
+	}
+
+	n, err := f.sink.Write(p)
+	if errors.Is(err, os.ErrClosed) {
+		return os.Stderr.Write(p)
+	}
+
+	return n, err
+}
+
+func (f *fallbackSink) Close() error {
+	return f.output.Close()
+}
@@ -0,0 +1,179 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// NameKey Поле с именем логгера (модуля)
+const NameKey = "logger"
+
+// levelAll Уровень базового обработчика: фильтрацию по уровню делает Registry
+const levelAll = slog.Level(math.MinInt)
+
+// NewRegistry Реестр именованных логгеров. handler должен пропускать все уровни (см. NewHandler),
+// level - уровень по умолчанию для логгеров без собственного уровня.
+func NewRegistry(handler slog.Handler, level slog.Level) *Registry {
+	r := &Registry{
+		handler: handler,
+		levels:  make(map[string]slog.Level),
+		loggers: make(map[string]*slog.Logger),
+	}
+	r.root.Set(level)
+
+	return r
+}
+
+// Registry Реестр именованных логгеров (по модулям) с уровнями, изменяемыми на лету
+type Registry struct {
+	handler slog.Handler
+	root    slog.LevelVar
+	mu      sync.RWMutex
+	levels  map[string]slog.Level
+	loggers map[string]*slog.Logger
+}
+
+// Logger Корневой логгер реестра
+func (r *Registry) Logger() *slog.Logger {
+	return r.Named("")
+}
+
+// Named Логгер с именем (обычно ModuleInterface.Name()). Уровень берётся из SetLevel(name), иначе общий.
+// Имена не зависят от регистра: Payments и payments - один логгер.
+func (r *Registry) Named(name string) *slog.Logger {
+	name = normalizeName(name)
+
+	r.mu.RLock()
+	l, ok := r.loggers[name]
+	r.mu.RUnlock()
+
+	if ok {
+		return l
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if l, ok = r.loggers[name]; ok {
+		return l
+	}
+
+	var handler slog.Handler = &levelHandler{registry: r, name: name, inner: r.handler}
+	if name != "" {
+		handler = handler.WithAttrs([]slog.Attr{slog.String(NameKey, name)})
+	}
+
+	l = slog.New(handler)
+	r.loggers[name] = l
+
+	return l
+}
+
+// Level Действующий уровень логгера
+func (r *Registry) Level(name string) slog.Level {
+	if name = normalizeName(name); name != "" {
+		r.mu.RLock()
+		level, ok := r.levels[name]
+		r.mu.RUnlock()
+
+		if ok {
+			return level
+		}
+	}
+
+	return r.root.Level()
+}
+
+// SetLevel Устанавливает уровень логгера. Пустое имя - общий уровень.
+func (r *Registry) SetLevel(name string, level slog.Level) {
+	if name = normalizeName(name); name == "" {
+		r.root.Set(level)
+
+		return
+	}
+
+	r.mu.Lock()
+	r.levels[name] = level
+	r.mu.Unlock()
+}
+
+// ResetLevel Сбрасывает собственный уровень логгера на общий
+func (r *Registry) ResetLevel(name string) {
+	r.mu.Lock()
+	delete(r.levels, normalizeName(name))
+	r.mu.Unlock()
+}
+
+// SetLevels Заменяет все собственные уровни логгеров (например, из LOG_LEVELS при перезагрузке конфига)
+func (r *Registry) SetLevels(levels map[string]slog.Level) {
+	r.mu.Lock()
+	r.levels = make(map[string]slog.Level, len(levels))
+	for name, level := range levels {
+		r.levels[normalizeName(name)] = level
+	}
+	r.mu.Unlock()
+}
+
+// normalizeName имя логгера без учёта регистра (LOG_LEVELS_PAYMENTS и LOG_LEVELS=Payments=debug)
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Levels Общий уровень (ключ "") и собственные уровни логгеров
+func (r *Registry) Levels() map[string]string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make(map[string]string, len(r.levels)+1)
+	result[""] = r.root.Level().String()
+
+	for name, level := range r.levels {
+		result[name] = level.String()
+	}
+
+	return result
+}
+
+// String Уровни в формате LOG_LEVELS: payments=DEBUG,http=WARN
+func (r *Registry) String() string {
+	levels := r.Levels()
+	parts := make([]string, 0, len(levels))
+
+	for name, level := range levels {
+		if name != "" {
+			parts = append(parts, fmt.Sprintf("%s=%s", name, level))
+		}
+	}
+
+	sort.Strings(parts)
+
+	return strings.Join(parts, ",")
+}
+
+// levelHandler Фильтрует записи по уровню логгера из реестра
+type levelHandler struct {
+	registry *Registry
+	name     string
+	inner    slog.Handler
+}
+
+func (h *levelHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.registry.Level(h.name) && h.inner.Enabled(ctx, level)
+}
+
+func (h *levelHandler) Handle(ctx context.Context, record slog.Record) error {
+	return h.inner.Handle(ctx, record)
+}
+
+func (h *levelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &levelHandler{registry: h.registry, name: h.name, inner: h.inner.WithAttrs(attrs)}
+}
+
+func (h *levelHandler) WithGroup(name string) slog.Handler {
+	return &levelHandler{registry: h.registry, name: h.name, inner: h.inner.WithGroup(name)}
+}
@@ -0,0 +1,83 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// SamplingOptions Настройки сэмплирования: в каждом интервале для одинаковых записей (уровень + сообщение)
+// пишутся первые First, затем каждая Thereafter-я. Записи уровня Error и выше пишутся всегда.
+type SamplingOptions struct {
+	Interval   time.Duration
+	First      int
+	Thereafter int
+}
+
+// NewSamplingHandler Обработчик, ограничивающий поток одинаковых записей из горячих участков кода
+func NewSamplingHandler(inner slog.Handler, opts SamplingOptions) slog.Handler {
+	return &samplingHandler{inner: inner, state: &samplingState{opts: opts, counts: make(map[samplingKey]int)}}
+}
+
+type samplingKey struct {
+	level slog.Level
+	msg   string
+}
+
+// samplingState общий для логгера и всех его With-производных
+type samplingState struct {
+	opts        SamplingOptions
+	mu          sync.Mutex
+	windowStart time.Time
+	counts      map[samplingKey]int
+}
+
+func (s *samplingState) allow(level slog.Level, msg string, now time.Time) bool {
+	if level >= slog.LevelError || s.opts.Interval <= 0 {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.windowStart) >= s.opts.Interval {
+		s.windowStart = now
+		s.counts = make(map[samplingKey]int)
+	}
+
+	key := samplingKey{level: level, msg: msg}
+	s.counts[key]++
+	n := s.counts[key]
+
+	if n <= s.opts.First {
+		return true
+	}
+
+	return s.opts.Thereafter > 0 && (n-s.opts.First)%s.opts.Thereafter == 0
+}
+
+type samplingHandler struct {
+	inner slog.Handler
+	state *samplingState
+}
+
+func (h *samplingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.inner.Enabled(ctx, level)
+}
+
+func (h *samplingHandler) Handle(ctx context.Context, record slog.Record) error {
+	if !h.state.allow(record.Level, record.Message, time.Now()) {
+		return nil
+	}
+
+	return h.inner.Handle(ctx, record)
+}
+
+func (h *samplingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &samplingHandler{inner: h.inner.WithAttrs(attrs), state: h.state}
+}
+
+func (h *samplingHandler) WithGroup(name string) slog.Handler {
+	return &samplingHandler{inner: h.inner.WithGroup(name), state: h.state}
+}
@@ -0,0 +1,245 @@
+package logger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Sink Приёмник логов. Обработчики slog пишут одну запись за один вызов Write.
+type Sink interface {
+	io.Writer
+	Close() error
+}
+
+// NewStdoutSink Вывод в stdout (Close не закрывает stdout)
+func NewStdoutSink() Sink {
+	return nopCloser{os.Stdout}
+}
+
+// NewStderrSink Вывод в stderr (Close не закрывает stderr)
+func NewStderrSink() Sink {
+	return nopCloser{os.Stderr}
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// NewMultiSink Пишет каждую запись во все приёмники
+func NewMultiSink(sinks ...Sink) Sink {
+	return multiSink(sinks)
+}
+
+type multiSink []Sink
+
+func (m multiSink) Write(p []byte) (int, error) {
+	errs := make([]error, 0)
+
+	for _, sink := range m {
+		if _, err := sink.Write(p); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return len(p), errors.Join(errs...)
+}
+
+func (m multiSink) Close() error {
+	errs := make([]error, 0)
+
+	for _, sink := range m {
+		if err := sink.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// NewRingBuffer Хранит в памяти последние size записей (для тестов и debug-endpoint'ов)
+func NewRingBuffer(size int) *RingBuffer {
+	if size <= 0 {
+		size = 1
+	}
+
+	return &RingBuffer{entries: make([]string, size)}
+}
+
+// RingBuffer Кольцевой буфер последних записей лога
+type RingBuffer struct {
+	mu      sync.Mutex
+	entries []string
+	next    int
+	full    bool
+}
+
+func (r *RingBuffer) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries[r.next] = strings.TrimRight(string(p), "\n")
+	r.next = (r.next + 1) % len(r.entries)
+
+	if r.next == 0 {
+		r.full = true
+	}
+
+	return len(p), nil
+}
+
+func (r *RingBuffer) Close() error {
+	return nil
+}
+
+// Entries Записи от старых к новым
+func (r *RingBuffer) Entries() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.full {
+		return append([]string(nil), r.entries[:r.next]...)
+	}
+
+	result := make([]string, 0, len(r.entries))
+	result = append(result, r.entries[r.next:]...)
+	result = append(result, r.entries[:r.next]...)
+
+	return result
+}
+
+// Reset Очищает буфер
+func (r *RingBuffer) Reset() {
+	r.mu.Lock()
+	r.entries = make([]string, len(r.entries))
+	r.next = 0
+	r.full = false
+	r.mu.Unlock()
+}
+
+// ServeHTTP Отдаёт последние записи (debug-endpoint, закройте авторизацией)
+func (r *RingBuffer) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+
+	for _, entry := range r.Entries() {
+		_, _ = io.WriteString(w, entry+"\n")
+	}
+}
+
+// NewAsyncSink Асинхронная запись: записи попадают в очередь размера buffer и пишутся в фоне.
+// При заполнении очереди Write ждёт (записи не теряются). Close дописывает очередь и закрывает sink.
+func NewAsyncSink(sink Sink, buffer int) *AsyncSink {
+	a := &AsyncSink{
+		sink:  sink,
+		queue: make(chan asyncItem, buffer),
+		done:  make(chan struct{}),
+	}
+
+	go a.run()
+
+	return a
+}
+
+// AsyncSink Буферизированный асинхронный приёмник
+type AsyncSink struct {
+	sink   Sink
+	queue  chan asyncItem
+	done   chan struct{}
+	mu     sync.RWMutex
+	closed bool
+}
+
+// asyncItem запись очереди или метка Flush (flushed закрывается, когда всё, что было в очереди до неё, записано)
+type asyncItem struct {
+	data    []byte
+	flushed chan struct{}
+}
+
+func (a *AsyncSink) run() {
+	defer close(a.done)
+
+	for item := range a.queue {
+		if item.flushed != nil {
+			close(item.flushed)
+
+			continue
+		}
+
+		if _, err := a.sink.Write(item.data); err != nil {
+			fmt.Fprintf(os.Stderr, "logger: async write error: %v\n", err)
+		}
+	}
+}
+
+// Write Ставит запись в очередь. После Close пишет напрямую во вложенный sink.
+func (a *AsyncSink) Write(p []byte) (int, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.closed {
+		return a.sink.Write(p)
+	}
+
+	// буфер slog переиспользуется после Write, поэтому копируем
+	buf := make([]byte, len(p))
+	copy(buf, p)
+
+	a.queue <- asyncItem{data: buf}
+
+	return len(p), nil
+}
+
+// Flush Ждёт записи всех записей, поставленных в очередь до вызова
+func (a *AsyncSink) Flush(ctx context.Context) error {
+	flushed := make(chan struct{})
+
+	a.mu.RLock()
+	if a.closed {
+		a.mu.RUnlock()
+
+		return nil
+	}
+
+	select {
+	case a.queue <- asyncItem{flushed: flushed}:
+		a.mu.RUnlock()
+	case <-ctx.Done():
+		a.mu.RUnlock()
+
+		return ctx.Err()
+	}
+
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close Дописывает очередь и закрывает вложенный sink
+func (a *AsyncSink) Close() error {
+	a.mu.Lock()
+	if a.closed {
+		a.mu.Unlock()
+
+		return nil
+	}
+
+	a.closed = true
+	close(a.queue)
+	a.mu.Unlock()
+
+	<-a.done
+
+	return a.sink.Close()
+}
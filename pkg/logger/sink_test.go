@@ -0,0 +1,210 @@
+package logger
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAsyncSinkConcurrentWriteAndFlush(t *testing.T) {
+	ring := NewRingBuffer(10000)
+	async := NewAsyncSink(ring, 4)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 0; i < 500; i++ {
+				if _, err := fmt.Fprintf(async, "w%d-%d\n", w, i); err != nil {
+					t.Errorf("write: %v", err)
+
+					return
+				}
+			}
+		}(w)
+	}
+
+	stop := make(chan struct{})
+	flushDone := make(chan struct{})
+
+	go func() {
+		defer close(flushDone)
+
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+
+			if err := async.Flush(context.Background()); err != nil {
+				t.Errorf("flush: %v", err)
+
+				return
+			}
+		}
+	}()
+
+	wg.Wait()
+	close(stop)
+	<-flushDone
+
+	if err := async.Flush(context.Background()); err != nil {
+		t.Fatalf("flush: %v", err)
+	}
+
+	if got := len(ring.Entries()); got != 8*500 {
+		t.Fatalf("entries after flush = %d, want %d", got, 8*500)
+	}
+
+	if err := async.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	if err := async.Flush(context.Background()); err != nil {
+		t.Fatalf("flush after close: %v", err)
+	}
+}
+
+func TestAsyncSinkFlushRespectsContext(t *testing.T) {
+	blocked := make(chan struct{})
+	async := NewAsyncSink(blockingSink{release: blocked}, 1)
+
+	_, _ = async.Write([]byte("first\n"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := async.Flush(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("flush = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	close(blocked)
+
+	if err := async.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+}
+
+func TestOutputWriteAfterClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.log")
+
+	out, err := NewOutput(Config{Output: "file", Async: true, AsyncBuffer: 16, File: FileConfig{Path: path}})
+	if err != nil {
+		t.Fatalf("new output: %v", err)
+	}
+
+	if _, err := out.Sink.Write([]byte("before close\n")); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	if err := out.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	// после Close запись уходит в stderr, а не теряется с os.ErrClosed
+	if _, err := out.Sink.Write([]byte("after close\n")); err != nil {
+		t.Fatalf("write after close: %v", err)
+	}
+
+	if lines := readLines(t, path); len(lines) != 1 || lines[0] != "before close" {
+		t.Fatalf("file lines = %q", lines)
+	}
+}
+
+func TestFileSinkConcurrentWriteWithRotation(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+
+	sink, err := NewFileSink(FileOptions{Path: path, MaxSize: 1024})
+	if err != nil {
+		t.Fatalf("new file sink: %v", err)
+	}
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 0; i < 200; i++ {
+				if _, err := fmt.Fprintf(sink, "w%d-%d\n", w, i); err != nil {
+					t.Errorf("write: %v", err)
+
+					return
+				}
+			}
+		}(w)
+	}
+
+	wg.Wait()
+
+	if err := sink.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "app*"))
+	if err != nil {
+		t.Fatalf("glob: %v", err)
+	}
+
+	if len(files) < 2 {
+		t.Fatalf("expected rotated files, got %v", files)
+	}
+
+	total := 0
+	for _, file := range files {
+		total += len(readLines(t, file))
+	}
+
+	if total != 8*200 {
+		t.Fatalf("lines in all files = %d, want %d", total, 8*200)
+	}
+}
+
+type blockingSink struct {
+	release chan struct{}
+}
+
+func (b blockingSink) Write(p []byte) (int, error) {
+	<-b.release
+
+	return len(p), nil
+}
+
+func (blockingSink) Close() error {
+	return nil
+}
+
+func readLines(t *testing.T, path string) []string {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open %s: %v", path, err)
+	}
+	defer f.Close()
+
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
@@ -0,0 +1,39 @@
+package reporter
+
+import (
+	"github.com/exgamer/gosdk-core/pkg/config"
+)
+
+// Config Настройки отправки ошибок
+type Config struct {
+	SentryDSN        config.Secret `mapstructure:"SENTRY_DSN" desc:"DSN Sentry, пусто - ошибки никуда не отправляются"`
+	SentrySampleRate float64       `mapstructure:"SENTRY_SAMPLE_RATE" default:"1" validate:"gte=0,lte=1" desc:"Доля отправляемых ошибок, от 0 до 1"`
+	SentryDebug      bool          `mapstructure:"SENTRY_DEBUG" default:"false" desc:"Отладочный вывод клиента Sentry"`
+}
+
+// New Reporter по настройкам: Sentry, если задан SENTRY_DSN, иначе Noop.
+// Окружение, релиз и имя сервера берутся из BaseConfig.
+func New(cfg Config, base config.BaseConfig) (Reporter, error) {
+	if cfg.SentryDSN.Value() == "" {
+		return Noop{}, nil
+	}
+
+	serverName := base.ContainerName
+	if serverName == "" {
+		serverName = base.Name
+	}
+
+	release := base.Name
+	if base.Version != "" {
+		release += "@" + base.Version
+	}
+
+	return NewSentry(SentryOptions{
+		DSN:         cfg.SentryDSN.Value(),
+		Environment: base.AppEnv.String(),
+		Release:     release,
+		ServerName:  serverName,
+		SampleRate:  cfg.SentrySampleRate,
+		Debug:       cfg.SentryDebug,
+	})
+}
@@ -0,0 +1,45 @@
+package reporter
+
+import (
+	"context"
+	"sync"
+)
+
+// Memory Reporter, который хранит ошибки в памяти (для тестов)
+type Memory struct {
+	mu     sync.Mutex
+	errors []error
+}
+
+func NewMemory() *Memory {
+	return &Memory{}
+}
+
+func (m *Memory) Capture(_ context.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	m.mu.Lock()
+	m.errors = append(m.errors, err)
+	m.mu.Unlock()
+}
+
+func (m *Memory) Flush(context.Context) error {
+	return nil
+}
+
+// Errors Отправленные ошибки
+func (m *Memory) Errors() []error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]error(nil), m.errors...)
+}
+
+// Reset Очищает список ошибок
+func (m *Memory) Reset() {
+	m.mu.Lock()
+	m.errors = nil
+	m.mu.Unlock()
+}
@@ -0,0 +1,45 @@
+package reporter
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// Reporter Отправка ошибок во внешнюю систему (Sentry и тп)
+type Reporter interface {
+	// Capture отправляет ошибку. Контекст AppException (errors.As) передаётся как дополнительные данные.
+	Capture(ctx context.Context, err error)
+	// Flush ждёт отправки накопленных ошибок
+	Flush(ctx context.Context) error
+}
+
+// defaultReporter Reporter по умолчанию (используется логгером для ошибок с TrackInSentry)
+var defaultReporter atomic.Pointer[holder]
+
+// holder atomic.Pointer не хранит интерфейсы напрямую
+type holder struct {
+	reporter Reporter
+}
+
+// Default Reporter по умолчанию. Если не установлен - ничего не отправляет.
+func Default() Reporter {
+	if h := defaultReporter.Load(); h != nil {
+		return h.reporter
+	}
+
+	return Noop{}
+}
+
+// SetDefault Устанавливает Reporter по умолчанию
+func SetDefault(r Reporter) {
+	defaultReporter.Store(&holder{reporter: r})
+}
+
+// Noop Reporter, который ничего не отправляет
+type Noop struct{}
+
+func (Noop) Capture(context.Context, error) {}
+
+func (Noop) Flush(context.Context) error {
+	return nil
+}
@@ -0,0 +1,83 @@
+package reporter
+
+import (
+	"context"
+	"errors"
+	"github.com/exgamer/gosdk-core/pkg/exception"
+	"github.com/getsentry/sentry-go"
+	"time"
+)
+
+// defaultFlushTimeout Время ожидания отправки, если у context нет дедлайна
+const defaultFlushTimeout = 5 * time.Second
+
+// SentryOptions Настройки клиента Sentry
+type SentryOptions struct {
+	DSN         string
+	Environment string
+	Release     string
+	ServerName  string
+	SampleRate  float64
+	Debug       bool
+}
+
+// Sentry Reporter, отправляющий ошибки в Sentry. Использует свой клиент, глобальный sentry.CurrentHub() не меняется.
+type Sentry struct {
+	hub *sentry.Hub
+}
+
+func NewSentry(opts SentryOptions) (*Sentry, error) {
+	client, err := sentry.NewClient(sentry.ClientOptions{
+		Dsn:         opts.DSN,
+		Environment: opts.Environment,
+		Release:     opts.Release,
+		ServerName:  opts.ServerName,
+		SampleRate:  opts.SampleRate,
+		Debug:       opts.Debug,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Sentry{hub: sentry.NewHub(client, sentry.NewScope())}, nil
+}
+
+// Capture Отправляет ошибку. Для AppException добавляются теги code/category и контекст; стек берётся из ошибки.
+func (s *Sentry) Capture(ctx context.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	hub := s.hub
+	if ctxHub := sentry.GetHubFromContext(ctx); ctxHub != nil && ctxHub.Client() == s.hub.Client() {
+		hub = ctxHub
+	}
+
+	hub.WithScope(func(scope *sentry.Scope) {
+		var ex *exception.AppException
+		if errors.As(err, &ex) {
+			scope.SetTag("code", ex.GetCode())
+			scope.SetTag("category", string(ex.GetCategory()))
+
+			if len(ex.Context) > 0 {
+				scope.SetContext("app_exception", ex.Context)
+			}
+		}
+
+		hub.CaptureException(err)
+	})
+}
+
+// Flush Ждёт отправки событий до дедлайна ctx (без дедлайна - 5 секунд)
+func (s *Sentry) Flush(ctx context.Context) error {
+	timeout := defaultFlushTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	if !s.hub.Flush(timeout) {
+		return errors.New("sentry flush timeout")
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package validation
 import (
 	"encoding/json"
 	"errors"
+	"github.com/exgamer/gosdk-core/pkg/exception"
 	"github.com/go-playground/validator/v10"
 	"github.com/gookit/validate"
 	"github.com/iancoleman/strcase"
@@ -45,3 +46,8 @@ func BindANdValidateStruct[T any](byte []byte, i *T) (map[string]string, error)
 
 	return nil, nil
 }
+
+// ValidationMapAsError - ошибки валидации (результат BindANdValidateStruct) как ошибка категории validation (nil, если ошибок нет)
+func ValidationMapAsError(fields map[string]string) error {
+	return exception.NewMultiErrorFromMap(fields).Err()
+}